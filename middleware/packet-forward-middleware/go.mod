@@ -19,6 +19,7 @@ require (
 	github.com/cosmos/cosmos-db v1.1.3
 	github.com/cosmos/cosmos-sdk v0.53.4
 	github.com/cosmos/gogoproto v1.7.0
+	github.com/cosmos/ibc-apps/modules/memo v0.0.0
 	github.com/cosmos/ibc-go/v10 v10.6.0
 	github.com/golang/mock v1.6.0
 	github.com/gorilla/mux v1.8.1
@@ -227,6 +228,9 @@ require (
 )
 
 replace (
+	// the memo registry is developed alongside the modules of this repository
+	github.com/cosmos/ibc-apps/modules/memo => ../../modules/memo
+
 	// cosmos keyring
 	github.com/99designs/keyring => github.com/cosmos/keyring v1.2.0
 
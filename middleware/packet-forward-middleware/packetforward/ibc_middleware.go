@@ -1,7 +1,6 @@
 package packetforward
 
 import (
-	"encoding/json"
 	"fmt"
 	"time"
 
@@ -179,21 +178,16 @@ func (im IBCMiddleware) OnRecvPacket(
 		"amount", data.Amount, "denom", data.Denom, "memo", data.Memo,
 	)
 
-	d := make(map[string]interface{})
-	err := json.Unmarshal([]byte(data.Memo), &d)
-	if err != nil || d["forward"] == nil {
-		// not a packet that should be forwarded
-		logger.Debug("packetForwardMiddleware OnRecvPacket forward metadata does not exist")
-		return im.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
-	}
-	m := &types.PacketMetadata{}
-	err = json.Unmarshal([]byte(data.Memo), m)
+	metadata, found, err := ParseForwardMetadata(data.Memo)
 	if err != nil {
 		logger.Error("packetForwardMiddleware OnRecvPacket error parsing forward metadata", "error", err)
 		return newErrorAcknowledgement(fmt.Errorf("error parsing forward metadata: %w", err))
 	}
-
-	metadata := m.Forward
+	if !found {
+		// not a packet that should be forwarded
+		logger.Debug("packetForwardMiddleware OnRecvPacket forward metadata does not exist")
+		return im.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
 
 	goCtx := ctx.Context()
 	nonrefundableCtxValue := goCtx.Value(types.NonrefundableKey{})
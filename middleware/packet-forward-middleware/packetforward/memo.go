@@ -0,0 +1,40 @@
+package packetforward
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/types"
+	"github.com/cosmos/ibc-apps/modules/memo"
+)
+
+// ForwardMemoKey is the memo key owned by the packet forward middleware
+const ForwardMemoKey = "forward"
+
+// RegisterMemoSections registers the memo key owned by the packet forward middleware ("forward") in the registry
+// shared by the middlewares of the transfer stack.
+func RegisterMemoSections(registry *memo.Registry) error {
+	return registry.Register(ForwardMemoKey, types.ModuleName, func(section json.RawMessage) error {
+		var metadata *types.ForwardMetadata
+		if err := json.Unmarshal(section, &metadata); err != nil {
+			return err
+		}
+		if metadata == nil {
+			return errors.New("forward metadata cannot be null")
+		}
+		return metadata.Validate()
+	})
+}
+
+// ParseForwardMetadata returns the forward section of the memo of a received packet. found is false if the memo
+// isn't a JSON object or has no (or a null) forward section, in which case the packet isn't forwarded.
+func ParseForwardMetadata(memoStr string) (metadata *types.ForwardMetadata, found bool, err error) {
+	parsed, err := memo.Parse(memoStr)
+	if err != nil {
+		return nil, false, nil
+	}
+	if _, err := parsed.Section(ForwardMemoKey, &metadata); err != nil {
+		return nil, true, err
+	}
+	return metadata, metadata != nil, nil
+}
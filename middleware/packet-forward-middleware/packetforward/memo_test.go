@@ -0,0 +1,74 @@
+package packetforward_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward"
+	"github.com/cosmos/ibc-apps/modules/memo"
+)
+
+func TestRegisterMemoSections(t *testing.T) {
+	registry := memo.NewRegistry(memo.Config{RejectUnknownKeys: true})
+	require.NoError(t, packetforward.RegisterMemoSections(registry))
+	require.Error(t, packetforward.RegisterMemoSections(registry))
+
+	testCases := []struct {
+		name   string
+		memo   string
+		expErr bool
+	}{
+		{"forward", `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-0"}}`, false},
+		{"forward with next", `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-0","next":{"forward":{}}}}`, false},
+		{"forward with timeout and retries", `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-0","timeout":"10m","retries":2}}`, false},
+		{"forward without receiver", `{"forward":{"port":"transfer","channel":"channel-0"}}`, true},
+		{"forward with invalid channel", `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"invalid"}}`, true},
+		{"forward not an object", `{"forward":"channel-0"}`, true},
+		{"null forward", `{"forward":null}`, true},
+		{"unknown key", `{"wasm":{}}`, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := registry.Parse(tc.memo)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseForwardMetadata(t *testing.T) {
+	testCases := []struct {
+		name     string
+		memo     string
+		expFound bool
+		expErr   bool
+	}{
+		{"forward", `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-0"}}`, true, false},
+		{"forward next to other keys", `{"wasm":{},"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-0"}}`, true, false},
+		{"empty memo", ``, false, false},
+		{"not json", `forward`, false, false},
+		{"no forward", `{"wasm":{}}`, false, false},
+		{"null forward", `{"forward":null}`, false, false},
+		{"forward not an object", `{"forward":"channel-0"}`, true, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata, found, err := packetforward.ParseForwardMetadata(tc.memo)
+			require.Equal(t, tc.expFound, found)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if found {
+				require.Equal(t, "channel-0", metadata.Channel)
+			}
+		})
+	}
+}
@@ -2,13 +2,28 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+const (
+	flagTimeout    = "timeout"
+	flagFee        = "fee"
+	flagFeeEscrow  = "fee-escrow"
+	flagPayer      = "payer"
+	flagClient     = "client"
+	defaultTimeout = 10 * time.Minute
 )
 
 // GetQueryCmd returns the query commands for async-icq
@@ -22,6 +37,10 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdParams(),
+		GetCmdQueryResult(),
+		GetCmdEffectiveAllowlist(),
+		GetCmdEffectiveClientAllowlist(),
+		GetCmdFeeEscrow(),
 	)
 
 	return queryCmd
@@ -54,7 +73,294 @@ func GetCmdParams() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryResult returns the command handler for querying the result of an interchain query.
+func GetCmdQueryResult() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "result [channel-id] [sequence]",
+		Short:   "Query the result of an interchain query sent with send-query",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query %s result channel-0 1", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence %s: %w", args[1], err)
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryResult(cmd.Context(), &types.QueryQueryResultRequest{
+				ChannelId: args[0],
+				Sequence:  sequence,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdEffectiveAllowlist returns the command handler for querying the query paths allowed on a host channel.
+func GetCmdEffectiveAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowlist [channel-id]",
+		Short:   "Query the query paths allowed on a host channel, and where they are set",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query %s allowlist channel-0", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EffectiveAllowlist(cmd.Context(), &types.QueryEffectiveAllowlistRequest{
+				ChannelId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdEffectiveClientAllowlist returns the command handler for querying the query paths allowed on a host client
+// receiving IBC v2 packets.
+func GetCmdEffectiveClientAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "client-allowlist [client-id]",
+		Short:   "Query the query paths allowed on a host client receiving IBC v2 packets, and where they are set",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query %s client-allowlist 07-tendermint-0", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EffectiveAllowlist(cmd.Context(), &types.QueryEffectiveAllowlistRequest{
+				ClientId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFeeEscrow returns the command handler for querying the fee escrow of a depositor for a host channel, or for
+// a host client receiving IBC v2 packets.
+func GetCmdFeeEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-escrow [channel-id] [depositor]",
+		Short:   "Query the fee escrow of a depositor for a host channel, or for a host client with --client",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query %s fee-escrow channel-0 cosmos1...", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			isClient, err := cmd.Flags().GetBool(flagClient)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFeeEscrowRequest{ChannelId: args[0], Depositor: args[1]}
+			if isClient {
+				req = &types.QueryFeeEscrowRequest{ClientId: args[0], Depositor: args[1]}
+			}
+			res, err := queryClient.FeeEscrow(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagClient, false, "Query the fee escrow of a host client instead of a host channel")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewTxCmd returns the transaction commands
 func NewTxCmd() *cobra.Command {
-	return nil
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Aliases:                    []string{"icq"},
+		Short:                      "Transaction commands for the " + types.ModuleName + " module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewSendQueryCmd(),
+		NewFundFeeEscrowCmd(),
+		NewWithdrawFeeEscrowCmd(),
+	)
+
+	return txCmd
+}
+
+// NewSendQueryCmd returns the command to send an interchain query. Each request is a gRPC method path followed by
+// the JSON encoded request message.
+func NewSendQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-query [channel-id] [path] [request-json] [[path] [request-json]...]",
+		Short: "Send an interchain query on a controller channel",
+		Args: func(_ *cobra.Command, args []string) error {
+			if len(args) < 3 || len(args)%2 == 0 {
+				return fmt.Errorf("expected a channel id followed by pairs of query path and JSON request, got %d args", len(args))
+			}
+			return nil
+		},
+		Example: fmt.Sprintf(
+			`%s tx %s send-query channel-0 /cosmos.bank.v1beta1.Query/Balance '{"address":"cosmos1...","denom":"uatom"}'`,
+			version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			timeout, err := cmd.Flags().GetDuration(flagTimeout)
+			if err != nil {
+				return err
+			}
+
+			var reqs []abci.RequestQuery
+			for i := 1; i < len(args); i += 2 {
+				path := args[i]
+				req, _, err := types.NewQueryMessages(path)
+				if err != nil {
+					return err
+				}
+				if err := clientCtx.Codec.UnmarshalJSON([]byte(args[i+1]), req); err != nil {
+					return fmt.Errorf("invalid request for %s: %w", path, err)
+				}
+				data, err := clientCtx.Codec.Marshal(req)
+				if err != nil {
+					return err
+				}
+				reqs = append(reqs, abci.RequestQuery{Path: path, Data: data})
+			}
+
+			msg := types.NewMsgSendQuery(clientCtx.GetFromAddress().String(), args[0], reqs, uint64(timeout))
+			feeStr, err := cmd.Flags().GetString(flagFee)
+			if err != nil {
+				return err
+			}
+			if feeStr != "" {
+				if msg.Fee, err = sdk.ParseCoinsNormalized(feeStr); err != nil {
+					return fmt.Errorf("invalid fee %s: %w", feeStr, err)
+				}
+			}
+			if msg.FeeEscrow, err = cmd.Flags().GetString(flagFeeEscrow); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(flagTimeout, defaultTimeout, "Timeout of the query packet, relative to the block time")
+	cmd.Flags().String(flagFee, "", "Maximum query fee, in the denoms of the host, paid from the fee escrow of --fee-escrow")
+	cmd.Flags().String(flagFeeEscrow, "", "Depositor, on the host, of the fee escrow of the host channel paying the query fee")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewFundFeeEscrowCmd returns the command to escrow funds to pay the query fees of a host channel, or of a host
+// client receiving IBC v2 packets.
+func NewFundFeeEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fund-fee-escrow [channel-id] [amount]",
+		Short:   "Escrow funds to pay the query fees of a host channel, or of a host client with --client",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s tx %s fund-fee-escrow channel-0 1000uatom --payer cosmos1...", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount %s: %w", args[1], err)
+			}
+			payer, err := cmd.Flags().GetString(flagPayer)
+			if err != nil {
+				return err
+			}
+			isClient, err := cmd.Flags().GetBool(flagClient)
+			if err != nil {
+				return err
+			}
+
+			depositor := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgFundFeeEscrow(depositor, args[0], payer, amount)
+			if isClient {
+				msg = types.NewMsgFundClientFeeEscrow(depositor, args[0], payer, amount)
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPayer, "", "Sender on the controller chain whose queries the escrow pays for; any sender if empty")
+	cmd.Flags().Bool(flagClient, false, "Fund the fee escrow of a host client instead of a host channel")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewWithdrawFeeEscrowCmd returns the command to withdraw the fee escrow of the sender for a host channel, or for a
+// host client receiving IBC v2 packets.
+func NewWithdrawFeeEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-fee-escrow [channel-id]",
+		Short:   "Withdraw the fee escrow of the sender for a host channel, or for a host client with --client",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx %s withdraw-fee-escrow channel-0", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			isClient, err := cmd.Flags().GetBool(flagClient)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawFeeEscrow(clientCtx.GetFromAddress().String(), args[0], "")
+			if isClient {
+				msg = types.NewMsgWithdrawFeeEscrow(clientCtx.GetFromAddress().String(), "", args[0])
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagClient, false, "Withdraw the fee escrow of a host client instead of a host channel")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
 }
@@ -17,7 +17,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 
-	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
 	ibccore "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 )
@@ -25,7 +24,6 @@ import (
 func hostEncoding() *moduletestutil.TestEncodingConfig {
 	cfg := cosmos.DefaultEncoding()
 
-	icqtypes.RegisterInterfaces(cfg.InterfaceRegistry)
 	ibccore.RegisterInterfaces(cfg.InterfaceRegistry)
 	ibctm.RegisterInterfaces(cfg.InterfaceRegistry)
 
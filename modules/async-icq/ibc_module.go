@@ -3,21 +3,20 @@ package icq
 import (
 	"strings"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/keeper"
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 
 	"cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
-	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
-	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
-	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
-	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
-// IBCModule implements the ICS26 interface for interchain query host chains
+// IBCModule implements the ICS26 interface for interchain query host chains, and for the controller of the
+// queries sent by the Go modules of the chain
 type IBCModule struct {
 	keeper keeper.Keeper
 }
@@ -33,13 +32,16 @@ func NewIBCModule(k keeper.Keeper) IBCModule {
 func (im IBCModule) OnChanOpenInit(
 	ctx sdk.Context,
 	order channeltypes.Order,
-	_ []string,
+	connectionHops []string,
 	portID string,
 	channelID string,
-	chanCap *capabilitytypes.Capability,
 	_ channeltypes.Counterparty,
 	version string,
 ) (string, error) {
+	if im.keeper.IsControllerPort(ctx, portID) {
+		return im.onControllerChanOpenInit(ctx, order, connectionHops, portID, version)
+	}
+
 	if !im.keeper.IsHostEnabled(ctx) {
 		return "", types.ErrHostDisabled
 	}
@@ -56,9 +58,35 @@ func (im IBCModule) OnChanOpenInit(
 		return "", errors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
 	}
 
-	// Claim channel capability passed back by IBC module
-	if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
-		return "", err
+	return version, nil
+}
+
+// onControllerChanOpenInit validates a channel opened by the controller. There can only be one open controller
+// channel per connection.
+func (im IBCModule) onControllerChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	version string,
+) (string, error) {
+	if order != channeltypes.UNORDERED {
+		return "", errors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+
+	if strings.TrimSpace(version) == "" {
+		version = types.Version
+	}
+
+	if version != types.Version {
+		return "", errors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	}
+
+	if activeChannelID, found := im.keeper.GetActiveChannelID(ctx, connectionHops[0]); found {
+		channel, found := im.keeper.GetChannel(ctx, portID, activeChannelID)
+		if found && channel.State != channeltypes.CLOSED {
+			return "", errors.Wrapf(types.ErrActiveChannelAlreadySet, "channel %s on connection %s", activeChannelID, connectionHops[0])
+		}
 	}
 
 	return version, nil
@@ -89,10 +117,13 @@ func (im IBCModule) OnChanOpenTry(
 	_ []string,
 	portID,
 	channelID string,
-	chanCap *capabilitytypes.Capability,
 	_ channeltypes.Counterparty,
 	counterpartyVersion string,
 ) (string, error) {
+	if im.keeper.IsControllerPort(ctx, portID) {
+		return "", errors.Wrap(types.ErrInvalidChannelFlow, "channel handshake must be initiated by the controller")
+	}
+
 	if !im.keeper.IsHostEnabled(ctx) {
 		return "", types.ErrHostDisabled
 	}
@@ -105,28 +136,30 @@ func (im IBCModule) OnChanOpenTry(
 		return "", errors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", counterpartyVersion, types.Version)
 	}
 
-	// Module may have already claimed capability in OnChanOpenInit in the case of crossing hellos
-	// (ie chainA and chainB both call ChanOpenInit before one of them calls ChanOpenTry)
-	// If module can already authenticate the capability then module already owns it so we don't need to claim
-	// Otherwise, module does not have channel capability and we must claim it from IBC
-	if !im.keeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
-		// Only claim channel capability passed back by IBC module if we do not already own it
-		if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
-			return "", err
-		}
-	}
-
 	return types.Version, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
 func (im IBCModule) OnChanOpenAck(
 	ctx sdk.Context,
-	_ string,
-	_ string,
+	portID string,
+	channelID string,
 	_ string,
 	counterpartyVersion string,
 ) error {
+	if im.keeper.IsControllerPort(ctx, portID) {
+		if counterpartyVersion != types.Version {
+			return errors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", counterpartyVersion, types.Version)
+		}
+
+		channel, found := im.keeper.GetChannel(ctx, portID, channelID)
+		if !found {
+			return errors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+		}
+		im.keeper.SetActiveChannelID(ctx, channel.ConnectionHops[0], channelID)
+		return nil
+	}
+
 	if !im.keeper.IsHostEnabled(ctx) {
 		return types.ErrHostDisabled
 	}
@@ -140,9 +173,13 @@ func (im IBCModule) OnChanOpenAck(
 // OnChanOpenConfirm implements the IBCModule interface
 func (im IBCModule) OnChanOpenConfirm(
 	ctx sdk.Context,
-	_ string,
+	portID string,
 	_ string,
 ) error {
+	if im.keeper.IsControllerPort(ctx, portID) {
+		return errors.Wrap(types.ErrInvalidChannelFlow, "channel handshake must be initiated by the controller")
+	}
+
 	if !im.keeper.IsHostEnabled(ctx) {
 		return types.ErrHostDisabled
 	}
@@ -171,9 +208,14 @@ func (im IBCModule) OnChanCloseConfirm(
 // OnRecvPacket implements the IBCModule interface
 func (im IBCModule) OnRecvPacket(
 	ctx sdk.Context,
+	_ string,
 	packet channeltypes.Packet,
 	_ sdk.AccAddress,
 ) ibcexported.Acknowledgement {
+	if im.keeper.IsControllerPort(ctx, packet.GetDestPort()) {
+		return channeltypes.NewErrorAcknowledgement(errors.Wrap(types.ErrInvalidChannelFlow, "cannot receive packet on a controller channel end"))
+	}
+
 	if !im.keeper.IsHostEnabled(ctx) {
 		return channeltypes.NewErrorAcknowledgement(types.ErrHostDisabled)
 	}
@@ -192,19 +234,29 @@ func (im IBCModule) OnRecvPacket(
 
 // OnAcknowledgementPacket implements the IBCModule interface
 func (im IBCModule) OnAcknowledgementPacket(
-	_ sdk.Context,
-	_ channeltypes.Packet,
-	_ []byte,
+	ctx sdk.Context,
+	_ string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
 	_ sdk.AccAddress,
 ) error {
+	if im.keeper.IsControllerPort(ctx, packet.GetSourcePort()) {
+		return im.keeper.OnAcknowledgementPacket(ctx, packet, acknowledgement)
+	}
+
 	return errors.Wrap(types.ErrInvalidChannelFlow, "cannot receive acknowledgement on a host channel end, a host chain does not send a packet over the channel")
 }
 
 // OnTimeoutPacket implements the IBCModule interface
 func (im IBCModule) OnTimeoutPacket(
-	_ sdk.Context,
-	_ channeltypes.Packet,
+	ctx sdk.Context,
+	_ string,
+	packet channeltypes.Packet,
 	_ sdk.AccAddress,
 ) error {
+	if im.keeper.IsControllerPort(ctx, packet.GetSourcePort()) {
+		return im.keeper.OnTimeoutPacket(ctx, packet)
+	}
+
 	return errors.Wrap(types.ErrInvalidChannelFlow, "cannot cause a packet timeout on a host channel end, a host chain does not send a packet over the channel")
 }
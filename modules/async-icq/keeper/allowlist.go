@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAllowlistOverride sets the allowlist of a host channel, connection or client, replacing the allow_queries of the
+// params
+func (k Keeper) SetAllowlistOverride(ctx sdk.Context, override types.AllowlistOverride) error {
+	if err := override.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AllowlistOverrideKey(override.ChannelId, override.ConnectionId, override.ClientId), k.cdc.MustMarshal(&override))
+	return nil
+}
+
+// GetAllowlistOverride returns the allowlist of a host channel, else of a connection, else of a host client
+func (k Keeper) GetAllowlistOverride(ctx sdk.Context, channelID, connectionID, clientID string) (types.AllowlistOverride, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AllowlistOverrideKey(channelID, connectionID, clientID))
+	if bz == nil {
+		return types.AllowlistOverride{}, false
+	}
+
+	var override types.AllowlistOverride
+	k.cdc.MustUnmarshal(bz, &override)
+	return override, true
+}
+
+// DeleteAllowlistOverride deletes the allowlist of a host channel, else of a connection, else of a host client
+func (k Keeper) DeleteAllowlistOverride(ctx sdk.Context, channelID, connectionID, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AllowlistOverrideKey(channelID, connectionID, clientID))
+}
+
+// GetAllAllowlistOverrides returns the allowlists of the host channels, followed by those of the connections and
+// of the host clients
+func (k Keeper) GetAllAllowlistOverrides(ctx sdk.Context) []types.AllowlistOverride {
+	var overrides []types.AllowlistOverride
+	for _, prefix := range [][]byte{types.ChannelAllowlistKeyPrefix, types.ConnectionAllowlistKeyPrefix, types.ClientAllowlistKeyPrefix} {
+		iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			var override types.AllowlistOverride
+			k.cdc.MustUnmarshal(iterator.Value(), &override)
+			overrides = append(overrides, override)
+		}
+		iterator.Close()
+	}
+	return overrides
+}
+
+// GetEffectiveAllowQueries returns the query paths allowed on a host channel, and their source: the allowlist of the
+// channel, else the allowlist of its connection, else the allow_queries of the params
+func (k Keeper) GetEffectiveAllowQueries(ctx sdk.Context, channelID string) ([]string, string) {
+	return k.effectiveAllowQueries(ctx, channelID, k.GetParams(ctx))
+}
+
+// effectiveAllowQueries returns the query paths allowed on a host channel, and their source, given the params
+func (k Keeper) effectiveAllowQueries(ctx sdk.Context, channelID string, params types.Params) ([]string, string) {
+	if override, found := k.GetAllowlistOverride(ctx, channelID, "", ""); found {
+		return override.AllowQueries, types.AllowlistSourceChannel
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, k.GetPort(ctx), channelID)
+	if found && len(channel.ConnectionHops) > 0 {
+		if override, found := k.GetAllowlistOverride(ctx, "", channel.ConnectionHops[0], ""); found {
+			return override.AllowQueries, types.AllowlistSourceConnection
+		}
+	}
+
+	return params.AllowQueries, types.AllowlistSourceParams
+}
+
+// GetEffectiveClientAllowQueries returns the query paths allowed on a host client receiving IBC v2 packets, and their
+// source: the allowlist of the client, else the allow_queries of the params
+func (k Keeper) GetEffectiveClientAllowQueries(ctx sdk.Context, clientID string) ([]string, string) {
+	return k.effectiveClientAllowQueries(ctx, clientID, k.GetParams(ctx))
+}
+
+// effectiveClientAllowQueries returns the query paths allowed on a host client, and their source, given the params
+func (k Keeper) effectiveClientAllowQueries(ctx sdk.Context, clientID string, params types.Params) ([]string, string) {
+	if override, found := k.GetAllowlistOverride(ctx, "", "", clientID); found {
+		return override.AllowQueries, types.AllowlistSourceClient
+	}
+	return params.AllowQueries, types.AllowlistSourceParams
+}
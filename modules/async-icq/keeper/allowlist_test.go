@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+func (suite *KeeperTestSuite) TestAllowlistOverrides() {
+	suite.SetupTest()
+
+	path := NewICQPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.Require().NoError(SetupICQPath(path))
+
+	icqKeeper := simapp.GetSimApp(suite.chainB).ICQKeeper
+	msgServer := keeper.NewMsgServerImpl(icqKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	ctx := suite.chainB.GetContext()
+	channelID, connectionID := path.EndpointB.ChannelID, path.EndpointB.ConnectionID
+
+	params := types.NewParams(true, []string{"/cosmos.staking.v1beta1.Query/*"})
+	suite.Require().NoError(icqKeeper.SetParams(ctx, params))
+
+	recv := func() error {
+		q := banktypes.QueryAllBalancesRequest{Address: suite.chainB.SenderAccount.GetAddress().String()}
+		data, err := types.SerializeCosmosQuery([]abcitypes.RequestQuery{{
+			Path: "/cosmos.bank.v1beta1.Query/AllBalances",
+			Data: simapp.GetSimApp(suite.chainB).AppCodec().MustMarshal(&q),
+		}})
+		suite.Require().NoError(err)
+		packet := channeltypes.NewPacket(
+			types.InterchainQueryPacketData{Data: data}.GetBytes(),
+			1,
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			path.EndpointB.ChannelConfig.PortID,
+			channelID,
+			clienttypes.NewHeight(1, 100),
+			0,
+		)
+		_, err = icqKeeper.OnRecvPacket(ctx, packet)
+		return err
+	}
+	effective := func() *types.QueryEffectiveAllowlistResponse {
+		res, err := icqKeeper.EffectiveAllowlist(ctx, &types.QueryEffectiveAllowlistRequest{ChannelId: channelID})
+		suite.Require().NoError(err)
+		return res
+	}
+
+	res := effective()
+	suite.Require().Equal(types.AllowlistSourceParams, res.Source)
+	suite.Require().Equal(params.AllowQueries, res.AllowQueries)
+	suite.Require().Error(recv())
+
+	// the allowlist of the connection replaces the params
+	connectionOverride := types.NewAllowlistOverride("", connectionID, []string{"/cosmos.bank.v1beta1.Query/*"})
+	_, err := msgServer.SetAllowlistOverride(ctx, types.NewMsgSetAllowlistOverride(authority, connectionOverride))
+	suite.Require().NoError(err)
+
+	res = effective()
+	suite.Require().Equal(types.AllowlistSourceConnection, res.Source)
+	suite.Require().Equal(connectionOverride.AllowQueries, res.AllowQueries)
+	suite.Require().NoError(recv())
+
+	// the allowlist of the channel replaces the one of the connection, even when empty
+	channelOverride := types.NewAllowlistOverride(channelID, "", nil)
+	_, err = msgServer.SetAllowlistOverride(ctx, types.NewMsgSetAllowlistOverride(authority, channelOverride))
+	suite.Require().NoError(err)
+
+	res = effective()
+	suite.Require().Equal(types.AllowlistSourceChannel, res.Source)
+	suite.Require().Empty(res.AllowQueries)
+	suite.Require().Error(recv())
+
+	suite.Require().ElementsMatch(
+		[]types.AllowlistOverride{channelOverride, connectionOverride},
+		icqKeeper.ExportGenesis(ctx).AllowlistOverrides,
+	)
+
+	_, err = msgServer.RemoveAllowlistOverride(ctx, types.NewMsgRemoveAllowlistOverride(suite.chainB.SenderAccount.GetAddress().String(), channelID, ""))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.RemoveAllowlistOverride(ctx, types.NewMsgRemoveAllowlistOverride(authority, channelID, ""))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.AllowlistSourceConnection, effective().Source)
+
+	_, err = msgServer.RemoveAllowlistOverride(ctx, types.NewMsgRemoveAllowlistOverride(authority, channelID, ""))
+	suite.Require().ErrorIs(err, types.ErrAllowlistNotFound)
+}
+
+func (suite *KeeperTestSuite) TestClientAllowlistOverrides() {
+	suite.SetupTest()
+
+	path := NewICQPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.Require().NoError(SetupICQPath(path))
+
+	icqKeeper := simapp.GetSimApp(suite.chainB).ICQKeeper
+	msgServer := keeper.NewMsgServerImpl(icqKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	ctx := suite.chainB.GetContext()
+	clientID := path.EndpointB.ClientID
+
+	params := types.NewParams(true, []string{"/cosmos.staking.v1beta1.Query/*"})
+	suite.Require().NoError(icqKeeper.SetParams(ctx, params))
+
+	recv := func() error {
+		q := banktypes.QueryAllBalancesRequest{Address: suite.chainB.SenderAccount.GetAddress().String()}
+		data, err := types.SerializeCosmosQuery([]abcitypes.RequestQuery{{
+			Path: "/cosmos.bank.v1beta1.Query/AllBalances",
+			Data: simapp.GetSimApp(suite.chainB).AppCodec().MustMarshal(&q),
+		}})
+		suite.Require().NoError(err)
+		_, err = icqKeeper.OnRecvClientPacket(ctx, clientID, 1, types.InterchainQueryPacketData{Data: data}.GetBytes())
+		return err
+	}
+	effective := func() *types.QueryEffectiveAllowlistResponse {
+		res, err := icqKeeper.EffectiveAllowlist(ctx, &types.QueryEffectiveAllowlistRequest{ClientId: clientID})
+		suite.Require().NoError(err)
+		return res
+	}
+
+	_, err := icqKeeper.EffectiveAllowlist(ctx, &types.QueryEffectiveAllowlistRequest{ChannelId: path.EndpointB.ChannelID, ClientId: clientID})
+	suite.Require().Error(err)
+
+	res := effective()
+	suite.Require().Equal(types.AllowlistSourceParams, res.Source)
+	suite.Require().Equal(params.AllowQueries, res.AllowQueries)
+	suite.Require().Error(recv())
+
+	// the allowlist of the client replaces the params for the IBC v2 packets, but not on the channels of its
+	// connection
+	clientOverride := types.NewClientAllowlistOverride(clientID, []string{"/cosmos.bank.v1beta1.Query/*"})
+	_, err = msgServer.SetAllowlistOverride(ctx, types.NewMsgSetAllowlistOverride(authority, clientOverride))
+	suite.Require().NoError(err)
+
+	res = effective()
+	suite.Require().Equal(types.AllowlistSourceClient, res.Source)
+	suite.Require().Equal(clientOverride.AllowQueries, res.AllowQueries)
+	suite.Require().NoError(recv())
+
+	allowQueries, source := icqKeeper.GetEffectiveAllowQueries(ctx, path.EndpointB.ChannelID)
+	suite.Require().Equal(types.AllowlistSourceParams, source)
+	suite.Require().Equal(params.AllowQueries, allowQueries)
+
+	suite.Require().Equal([]types.AllowlistOverride{clientOverride}, icqKeeper.ExportGenesis(ctx).AllowlistOverrides)
+
+	_, err = msgServer.RemoveAllowlistOverride(ctx, types.NewMsgRemoveClientAllowlistOverride(authority, clientID))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.AllowlistSourceParams, effective().Source)
+	suite.Require().Error(recv())
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterContractCallbackHandler enables the queries of CosmWasm contracts: the results of the queries sent with
+// MsgSendQuery and contract_callback set are delivered to the sender contracts with sudo messages. It must be called
+// when the app is created.
+func (k Keeper) RegisterContractCallbackHandler(contractKeeper types.ContractKeeper) {
+	k.RegisterCallbackHandler(types.ContractCallbackID, contractCallbackHandler{
+		results:        queryResultHandler{k},
+		contractKeeper: contractKeeper,
+	})
+}
+
+// contractCallbackHandler stores the results of the queries of the contracts like the ones of the other senders,
+// and sends them to the contracts
+type contractCallbackHandler struct {
+	results        queryResultHandler
+	contractKeeper types.ContractKeeper
+}
+
+var _ types.QueryCallbackHandler = contractCallbackHandler{}
+
+// OnQueryResult stores the result and sends it to the contract, with the contract callback gas limit of the params.
+// The failure of the contract, including running out of its gas limit, doesn't discard the stored result, and is
+// emitted as a callback error event instead.
+func (h contractCallbackHandler) OnQueryResult(ctx sdk.Context, channelID string, sequence uint64, response *types.CosmosResponse, queryErr error) error {
+	if err := h.results.OnQueryResult(ctx, channelID, sequence, response, queryErr); err != nil {
+		return err
+	}
+	result, _ := h.results.keeper.GetQueryResult(ctx, channelID, sequence)
+	contractAddr, err := sdk.AccAddressFromBech32(result.Sender)
+	if err != nil {
+		return errors.Wrap(err, "invalid contract address")
+	}
+
+	sudoMsg, err := contractSudoMsg(channelID, sequence, response, queryErr)
+	if err != nil {
+		return err
+	}
+	gasLimit := h.results.keeper.GetParams(ctx).ContractCallbackGasLimit
+	if gasLimit == 0 {
+		gasLimit = types.DefaultContractCallbackGasLimit
+	}
+	err = executeWithGasLimit(ctx, gasLimit, "interchain query contract callback", func(ctx sdk.Context) error {
+		return applyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			_, err := h.contractKeeper.Sudo(ctx, contractAddr, sudoMsg)
+			return err
+		})
+	})
+	if err != nil {
+		EmitCallbackErrorEvent(ctx, types.ContractCallbackID, channelID, sequence, err)
+	}
+	return nil
+}
+
+// isContract returns true if the address is the one of a contract
+func (h contractCallbackHandler) isContract(ctx sdk.Context, address string) bool {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return false
+	}
+	return h.contractKeeper.HasContractInfo(ctx, addr)
+}
+
+// contractSudoMsg returns the icq_timeout sudo message of a query that timed out, or else its icq_response
+func contractSudoMsg(channelID string, sequence uint64, response *types.CosmosResponse, queryErr error) ([]byte, error) {
+	if errors.IsOf(queryErr, types.ErrQueryTimeout) {
+		return json.Marshal(types.ICQTimeoutSudoMsg{
+			ICQTimeout: types.ICQTimeout{Channel: channelID, Sequence: sequence},
+		})
+	}
+
+	msg := types.ICQResponseSudoMsg{
+		ICQResponse: types.ICQResponse{Channel: channelID, Sequence: sequence},
+	}
+	if queryErr != nil {
+		msg.ICQResponse.Error = queryErr.Error()
+		return json.Marshal(msg)
+	}
+	msg.ICQResponse.Success = true
+	for _, resp := range response.Responses {
+		msg.ICQResponse.Responses = append(msg.ICQResponse.Responses, types.ContractQueryResponse{
+			Code:   resp.Code,
+			Key:    resp.Key,
+			Value:  resp.Value,
+			Height: resp.Height,
+		})
+	}
+	return json.Marshal(msg)
+}
@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+type sudoCall struct {
+	contract sdk.AccAddress
+	msg      []byte
+}
+
+// mockContractKeeper records the sudo messages sent to the contract, which uses gas to process them and fails them
+// with err
+type mockContractKeeper struct {
+	contract sdk.AccAddress
+	calls    *[]sudoCall
+	gas      uint64
+	err      error
+}
+
+func (k mockContractKeeper) Sudo(ctx context.Context, contract sdk.AccAddress, msg []byte) ([]byte, error) {
+	*k.calls = append(*k.calls, sudoCall{contract, msg})
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(k.gas, "contract")
+	return nil, k.err
+}
+
+func (k mockContractKeeper) HasContractInfo(_ context.Context, contract sdk.AccAddress) bool {
+	return contract.Equals(k.contract)
+}
+
+func (suite *KeeperTestSuite) TestContractCallbacks() {
+	var (
+		path     *ibctesting.Path
+		calls    []sudoCall
+		contract sdk.AccAddress
+	)
+
+	setup := func(contractGas uint64, contractErr error) {
+		suite.SetupTest()
+		calls = nil
+		contract = suite.chainA.SenderAccount.GetAddress()
+
+		path = NewICQControllerPath(suite.chainA, suite.chainB)
+		suite.coordinator.SetupConnections(path)
+		suite.Require().NoError(SetupICQPath(path))
+
+		simapp.GetSimApp(suite.chainA).ICQKeeper.RegisterContractCallbackHandler(mockContractKeeper{contract, &calls, contractGas, contractErr})
+
+		params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.Query/AllBalances"})
+		suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+	}
+
+	send := func(queryPath string, timeout time.Duration) (channeltypes.Packet, error) {
+		q := banktypes.QueryAllBalancesRequest{Address: suite.chainB.SenderAccount.GetAddress().String()}
+		reqs := []abcitypes.RequestQuery{{
+			Path: queryPath,
+			Data: simapp.GetSimApp(suite.chainA).AppCodec().MustMarshal(&q),
+		}}
+		msg := types.NewMsgSendQuery(contract.String(), path.EndpointA.ChannelID, reqs, uint64(timeout))
+		msg.ContractCallback = true
+
+		ctx := suite.chainA.GetContext()
+		res, err := keeper.NewMsgServerImpl(simapp.GetSimApp(suite.chainA).ICQKeeper).SendQuery(ctx, msg)
+		if err != nil {
+			return channeltypes.Packet{}, err
+		}
+		suite.coordinator.CommitBlock(suite.chainA)
+
+		data, err := types.SerializeCosmosQuery(reqs)
+		suite.Require().NoError(err)
+		return channeltypes.NewPacket(
+			types.InterchainQueryPacketData{Data: data}.GetBytes(),
+			res.Sequence,
+			types.ControllerPortID,
+			path.EndpointA.ChannelID,
+			types.PortID,
+			path.EndpointB.ChannelID,
+			clienttypes.ZeroHeight(),
+			uint64(ctx.BlockTime().Add(timeout).UnixNano()),
+		), nil
+	}
+
+	suite.Run("the responses are sent to the contract", func() {
+		setup(0, nil)
+		packet, err := send("/cosmos.bank.v1beta1.Query/AllBalances", time.Hour)
+		suite.Require().NoError(err)
+
+		suite.Require().NoError(path.RelayPacket(packet))
+		suite.Require().Len(calls, 1)
+		suite.Require().Equal(contract, calls[0].contract)
+
+		var msg types.ICQResponseSudoMsg
+		suite.Require().NoError(json.Unmarshal(calls[0].msg, &msg))
+		suite.Require().Equal(path.EndpointA.ChannelID, msg.ICQResponse.Channel)
+		suite.Require().Equal(packet.GetSequence(), msg.ICQResponse.Sequence)
+		suite.Require().True(msg.ICQResponse.Success)
+		suite.Require().Len(msg.ICQResponse.Responses, 1)
+
+		var balances banktypes.QueryAllBalancesResponse
+		suite.Require().NoError(simapp.GetSimApp(suite.chainA).AppCodec().Unmarshal(msg.ICQResponse.Responses[0].Value, &balances))
+		suite.Require().False(balances.Balances.IsZero())
+
+		result, found := simapp.GetSimApp(suite.chainA).ICQKeeper.GetQueryResult(suite.chainA.GetContext(), path.EndpointA.ChannelID, packet.GetSequence())
+		suite.Require().True(found)
+		suite.Require().Equal(types.QueryStatusSuccess, result.Status)
+	})
+
+	suite.Run("an error acknowledgement is sent to the contract", func() {
+		setup(0, nil)
+		packet, err := send("/cosmos.bank.v1beta1.Query/Balance", time.Hour)
+		suite.Require().NoError(err)
+
+		suite.Require().NoError(path.RelayPacket(packet))
+		suite.Require().Len(calls, 1)
+
+		var msg types.ICQResponseSudoMsg
+		suite.Require().NoError(json.Unmarshal(calls[0].msg, &msg))
+		suite.Require().False(msg.ICQResponse.Success)
+		suite.Require().Empty(msg.ICQResponse.Responses)
+		suite.Require().NotEmpty(msg.ICQResponse.Error)
+	})
+
+	suite.Run("a timeout is sent to the contract", func() {
+		setup(0, nil)
+		packet, err := send("/cosmos.bank.v1beta1.Query/AllBalances", time.Second)
+		suite.Require().NoError(err)
+
+		suite.coordinator.IncrementTimeBy(time.Minute)
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+		suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+		suite.Require().Len(calls, 1)
+		suite.Require().JSONEq(
+			fmt.Sprintf(`{"icq_timeout": {"channel": %q, "sequence": 1}}`, path.EndpointA.ChannelID),
+			string(calls[0].msg),
+		)
+	})
+
+	suite.Run("the result is stored when the contract fails", func() {
+		setup(0, errors.New("contract failed"))
+		packet, err := send("/cosmos.bank.v1beta1.Query/AllBalances", time.Hour)
+		suite.Require().NoError(err)
+
+		suite.Require().NoError(path.RelayPacket(packet))
+		suite.Require().Len(calls, 1)
+
+		result, found := simapp.GetSimApp(suite.chainA).ICQKeeper.GetQueryResult(suite.chainA.GetContext(), path.EndpointA.ChannelID, packet.GetSequence())
+		suite.Require().True(found)
+		suite.Require().Equal(types.QueryStatusSuccess, result.Status)
+	})
+
+	suite.Run("the contract runs out of its callback gas limit", func() {
+		setup(types.DefaultContractCallbackGasLimit+1, nil)
+		packet, err := send("/cosmos.bank.v1beta1.Query/AllBalances", time.Hour)
+		suite.Require().NoError(err)
+
+		ack, err := recvQuery(path, packet)
+		suite.Require().NoError(err)
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+
+		// the acknowledgement doesn't fail, and the result is stored
+		ctx := suite.chainA.GetContext().WithEventManager(sdk.NewEventManager())
+		suite.Require().NoError(simapp.GetSimApp(suite.chainA).ICQKeeper.OnAcknowledgementPacket(ctx, packet, ack))
+		suite.Require().Len(calls, 1)
+		suite.Require().Equal(types.EventTypeCallbackError, ctx.EventManager().Events()[0].Type)
+
+		result, found := simapp.GetSimApp(suite.chainA).ICQKeeper.GetQueryResult(suite.chainA.GetContext(), path.EndpointA.ChannelID, packet.GetSequence())
+		suite.Require().True(found)
+		suite.Require().Equal(types.QueryStatusSuccess, result.Status)
+	})
+
+	suite.Run("the sender must be a contract", func() {
+		setup(0, nil)
+		contract = sdk.AccAddress("not a contract")
+
+		_, err := send("/cosmos.bank.v1beta1.Query/AllBalances", time.Hour)
+		suite.Require().ErrorIs(err, types.ErrSenderNotContract)
+	})
+
+	suite.Run("contract callbacks must be enabled", func() {
+		suite.SetupTest()
+		contract = suite.chainA.SenderAccount.GetAddress()
+		path = NewICQControllerPath(suite.chainA, suite.chainB)
+		suite.coordinator.SetupConnections(path)
+		suite.Require().NoError(SetupICQPath(path))
+
+		_, err := send("/cosmos.bank.v1beta1.Query/AllBalances", time.Hour)
+		suite.Require().ErrorIs(err, types.ErrCallbackNotFound)
+	})
+}
@@ -0,0 +1,280 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// GetControllerPort returns the port the controller sends the queries from, or an empty string if the controller
+// isn't bound to a port
+func (k Keeper) GetControllerPort(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.ControllerPortKey))
+}
+
+// SetControllerPort sets the port the controller sends the queries from
+func (k Keeper) SetControllerPort(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ControllerPortKey, []byte(portID))
+}
+
+// IsControllerPort returns true if the port is the one the controller sends the queries from
+func (k Keeper) IsControllerPort(ctx sdk.Context, portID string) bool {
+	controllerPort := k.GetControllerPort(ctx)
+	return controllerPort != "" && controllerPort == portID
+}
+
+// RegisterCallbackHandler registers the handler of the results of the queries sent with the module name as
+// callback ID. It must be called when the app is created, and panics if a handler is already registered for the
+// module.
+func (k Keeper) RegisterCallbackHandler(module string, handler types.QueryCallbackHandler) {
+	if module == "" {
+		panic("cannot register an interchain query callback handler without a module name")
+	}
+	if _, found := k.callbackHandlers[module]; found {
+		panic(fmt.Sprintf("interchain query callback handler already registered for module %s", module))
+	}
+	k.callbackHandlers[module] = handler
+}
+
+// GetActiveChannelID returns the controller channel of the connection
+func (k Keeper) GetActiveChannelID(ctx sdk.Context, connectionID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ActiveChannelKey(connectionID))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetActiveChannelID sets the controller channel of the connection
+func (k Keeper) SetActiveChannelID(ctx sdk.Context, connectionID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ActiveChannelKey(connectionID), []byte(channelID))
+}
+
+// GetAllActiveChannels returns the controller channels of all the connections
+func (k Keeper) GetAllActiveChannels(ctx sdk.Context) []types.ActiveChannel {
+	var activeChannels []types.ActiveChannel
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ActiveChannelKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		activeChannels = append(activeChannels, types.ActiveChannel{
+			ConnectionId: string(iterator.Key()[len(types.ActiveChannelKeyPrefix):]),
+			ChannelId:    string(iterator.Value()),
+		})
+	}
+	return activeChannels
+}
+
+// GetPendingQuery returns a query awaiting its acknowledgement or timeout
+func (k Keeper) GetPendingQuery(ctx sdk.Context, channelID string, sequence uint64) (types.PendingQuery, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingQueryKey(channelID, sequence))
+	if bz == nil {
+		return types.PendingQuery{}, false
+	}
+
+	var pendingQuery types.PendingQuery
+	k.cdc.MustUnmarshal(bz, &pendingQuery)
+	return pendingQuery, true
+}
+
+// SetPendingQuery stores a query sent on the controller channel
+func (k Keeper) SetPendingQuery(ctx sdk.Context, pendingQuery types.PendingQuery) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingQueryKey(pendingQuery.ChannelId, pendingQuery.Sequence), k.cdc.MustMarshal(&pendingQuery))
+}
+
+// DeletePendingQuery deletes a query once its result has been handled
+func (k Keeper) DeletePendingQuery(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingQueryKey(channelID, sequence))
+}
+
+// GetAllPendingQueries returns the queries awaiting their acknowledgement or timeout
+func (k Keeper) GetAllPendingQueries(ctx sdk.Context) []types.PendingQuery {
+	var pendingQueries []types.PendingQuery
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PendingQueryKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingQuery types.PendingQuery
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingQuery)
+		pendingQueries = append(pendingQueries, pendingQuery)
+	}
+	return pendingQueries
+}
+
+// SendQuery sends the query requests to the host chain on the other end of the connection, through the active
+// controller channel of the connection. The timeout is relative to the block time, in nanoseconds. The result of
+// the query is passed to the callback handler registered with the callbackID, and the returned sequence identifies
+// the query in the result.
+func (k Keeper) SendQuery(
+	ctx sdk.Context,
+	connectionID string,
+	reqs []abci.RequestQuery,
+	timeout uint64,
+	callbackID string,
+) (uint64, error) {
+	return k.SendQueryWithFee(ctx, connectionID, reqs, timeout, callbackID, types.FeeMemo{})
+}
+
+// SendQueryWithFee sends the query requests like SendQuery, with the fee section in the memo of the packet data. The
+// host charges the fees of the requests, up to the maximum fee, from the fee escrow of the host channel named by the
+// fee section, which must pay for the queries of its payer.
+func (k Keeper) SendQueryWithFee(
+	ctx sdk.Context,
+	connectionID string,
+	reqs []abci.RequestQuery,
+	timeout uint64,
+	callbackID string,
+	fee types.FeeMemo,
+) (uint64, error) {
+	sourceChannel, found := k.GetActiveChannelID(ctx, connectionID)
+	if !found {
+		return 0, errors.Wrapf(types.ErrActiveChannelNotFound, "connection %s", connectionID)
+	}
+	return k.sendQuery(ctx, sourceChannel, reqs, timeout, callbackID, fee)
+}
+
+// sendQuery sends the query requests on the controller channel, with the fee section in the memo of the packet data
+func (k Keeper) sendQuery(
+	ctx sdk.Context,
+	sourceChannel string,
+	reqs []abci.RequestQuery,
+	timeout uint64,
+	callbackID string,
+	fee types.FeeMemo,
+) (uint64, error) {
+	if len(reqs) == 0 {
+		return 0, errors.Wrap(types.ErrInvalidQuery, "no query requests")
+	}
+	if timeout == 0 {
+		return 0, errors.Wrap(types.ErrInvalidQuery, "timeout must be positive")
+	}
+	if _, found := k.callbackHandlers[callbackID]; !found {
+		return 0, errors.Wrapf(types.ErrCallbackNotFound, "callback id %s", callbackID)
+	}
+
+	sourcePort := k.GetControllerPort(ctx)
+	if sourcePort == "" {
+		return 0, errors.Wrap(types.ErrInvalidControllerPort, "controller is not bound to a port")
+	}
+	channel, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, errors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+	if channel.State != channeltypes.OPEN {
+		return 0, errors.Wrapf(channeltypes.ErrInvalidChannelState, "channel %s is %s", sourceChannel, channel.State)
+	}
+
+	// the responses to the requests sent without a height are proven at least at the latest height of the client,
+	// so that the host cannot answer them with older state
+	clientID, _, err := k.channelKeeper.GetChannelClientState(ctx, sourcePort, sourceChannel)
+	if err != nil {
+		return 0, err
+	}
+	minProofHeight := k.clientKeeper.GetClientLatestHeight(ctx, clientID).GetRevisionHeight()
+
+	data, err := types.SerializeCosmosQuery(reqs)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not serialize reqs into cosmos query")
+	}
+	icqPacketData := types.InterchainQueryPacketData{
+		Data: data,
+		Memo: types.NewFeeMemo(fee),
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + timeout
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, clienttypes.ZeroHeight(), timeoutTimestamp, icqPacketData.GetBytes())
+	if err != nil {
+		return 0, err
+	}
+
+	k.SetPendingQuery(ctx, types.PendingQuery{
+		ChannelId:      sourceChannel,
+		Sequence:       sequence,
+		CallbackId:     callbackID,
+		MinProofHeight: minProofHeight,
+	})
+	return sequence, nil
+}
+
+// OnAcknowledgementPacket passes the responses of the host, or the error of the acknowledgement, to the callback
+// handler of the query. The proofs of the store queries are verified first, and ErrInvalidProof is passed to the
+// handler if any of them is invalid. The acknowledgement fails, and is kept to be relayed again, while the client
+// cannot verify the proofs yet.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errors.Wrapf(types.ErrUnknownDataType, "cannot unmarshal ICQ packet acknowledgement: %v", err)
+	}
+
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		var ackData types.InterchainQueryPacketAck
+		if err := types.ModuleCdc.UnmarshalJSON(resp.Result, &ackData); err != nil {
+			return errors.Wrap(err, "failed to unmarshal interchain query packet ack")
+		}
+		response, err := types.DeserializeCosmosResponseWithFee(ackData.Data)
+		if err != nil {
+			return errors.Wrap(err, "could not deserialize data to cosmos response")
+		}
+		pendingQuery, _ := k.GetPendingQuery(ctx, packet.GetSourceChannel(), packet.GetSequence())
+		if err := k.verifyQueryProofs(ctx, packet, response.Responses, pendingQuery.MinProofHeight); err != nil {
+			if errors.IsOf(err, clienttypes.ErrClientNotActive, clienttypes.ErrConsensusStateNotFound) {
+				return err
+			}
+			k.handleQueryResult(ctx, packet, nil, err)
+			return nil
+		}
+		k.handleQueryResult(ctx, packet, &response, nil)
+	case *channeltypes.Acknowledgement_Error:
+		k.handleQueryResult(ctx, packet, nil, errors.Wrap(types.ErrQueryFailed, resp.Error))
+	default:
+		return errors.Wrapf(types.ErrUnknownDataType, "unknown acknowledgement response type %T", resp)
+	}
+	return nil
+}
+
+// OnTimeoutPacket passes ErrQueryTimeout to the callback handler of the query.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	k.handleQueryResult(ctx, packet, nil, errors.Wrapf(types.ErrQueryTimeout, "sequence %d", packet.GetSequence()))
+	return nil
+}
+
+// handleQueryResult calls the callback handler of the query. The failure of the handler doesn't fail the
+// acknowledgement or timeout of the packet, which would otherwise be relayed again.
+func (k Keeper) handleQueryResult(ctx sdk.Context, packet channeltypes.Packet, response *types.CosmosResponse, queryErr error) {
+	channelID, sequence := packet.GetSourceChannel(), packet.GetSequence()
+	pendingQuery, found := k.GetPendingQuery(ctx, channelID, sequence)
+	if !found {
+		return
+	}
+	k.DeletePendingQuery(ctx, channelID, sequence)
+	callbackID := pendingQuery.CallbackId
+
+	handler, found := k.callbackHandlers[callbackID]
+	if !found {
+		k.Logger(ctx).Error("no interchain query callback handler", "callback_id", callbackID, "sequence", sequence)
+		return
+	}
+
+	err := applyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return handler.OnQueryResult(ctx, channelID, sequence, response, queryErr)
+	})
+	if err != nil {
+		EmitCallbackErrorEvent(ctx, callbackID, channelID, sequence, err)
+	}
+}
@@ -0,0 +1,450 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+const testCallbackID = "icq-test"
+
+type queryResult struct {
+	channelID string
+	sequence  uint64
+	response  *types.CosmosResponse
+	err       error
+}
+
+// testCallbackHandler records the results of the queries it is called with
+type testCallbackHandler struct {
+	results *[]queryResult
+}
+
+func (h testCallbackHandler) OnQueryResult(_ sdk.Context, channelID string, sequence uint64, response *types.CosmosResponse, err error) error {
+	*h.results = append(*h.results, queryResult{channelID, sequence, response, err})
+	return nil
+}
+
+func NewICQControllerPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := NewICQPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = types.ControllerPortID
+	return path
+}
+
+// recvQuery relays the packet to the host and returns its acknowledgement
+func recvQuery(path *ibctesting.Path, packet channeltypes.Packet) ([]byte, error) {
+	if err := path.EndpointB.UpdateClient(); err != nil {
+		return nil, err
+	}
+	proof, proofHeight := path.EndpointA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	recvMsg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, path.EndpointB.Chain.SenderAccount.GetAddress().String())
+	res, err := path.EndpointB.Chain.SendMsgs(recvMsg)
+	if err != nil {
+		return nil, err
+	}
+	return ibctesting.ParseAckFromEvents(res.GetEvents())
+}
+
+// latestHeaderUpdate returns the update of the controller client with the latest header of the host, without
+// committing a new block on the host like path.EndpointA.UpdateClient
+func latestHeaderUpdate(path *ibctesting.Path) (*clienttypes.MsgUpdateClient, error) {
+	trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+	if !ok {
+		return nil, fmt.Errorf("unexpected client height %s", path.EndpointA.GetClientLatestHeight())
+	}
+	header, err := path.EndpointB.Chain.IBCClientHeader(path.EndpointB.Chain.LatestCommittedHeader, trustedHeight)
+	if err != nil {
+		return nil, err
+	}
+	return clienttypes.NewMsgUpdateClient(path.EndpointA.ClientID, header, path.EndpointA.Chain.SenderAccount.GetAddress().String())
+}
+
+// relayProvenQuery relays the packet and its acknowledgement like path.RelayPacket, and additionally updates the
+// controller client with the header of the block receiving the packet, which proves the store queries
+func relayProvenQuery(path *ibctesting.Path, packet channeltypes.Packet) error {
+	ack, err := recvQuery(path, packet)
+	if err != nil {
+		return err
+	}
+	updateMsg, err := latestHeaderUpdate(path)
+	if err != nil {
+		return err
+	}
+	if _, err := path.EndpointA.Chain.SendMsgs(updateMsg); err != nil {
+		return err
+	}
+	if err := path.EndpointA.UpdateClient(); err != nil {
+		return err
+	}
+	return path.EndpointA.AcknowledgePacket(packet, ack)
+}
+
+func (suite *KeeperTestSuite) TestSendQuery() {
+	var (
+		path    *ibctesting.Path
+		results []queryResult
+	)
+
+	setup := func() {
+		suite.SetupTest()
+		results = nil
+
+		path = NewICQControllerPath(suite.chainA, suite.chainB)
+		suite.coordinator.SetupConnections(path)
+		suite.Require().NoError(SetupICQPath(path))
+
+		simapp.GetSimApp(suite.chainA).ICQKeeper.RegisterCallbackHandler(testCallbackID, testCallbackHandler{&results})
+
+		params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.Query/AllBalances"})
+		suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+	}
+
+	query := func() []abcitypes.RequestQuery {
+		q := banktypes.QueryAllBalancesRequest{Address: suite.chainB.SenderAccount.GetAddress().String()}
+		return []abcitypes.RequestQuery{{
+			Path: "/cosmos.bank.v1beta1.Query/AllBalances",
+			Data: simapp.GetSimApp(suite.chainA).AppCodec().MustMarshal(&q),
+		}}
+	}
+
+	send := func(reqs []abcitypes.RequestQuery, timeout time.Duration) (channeltypes.Packet, error) {
+		ctx := suite.chainA.GetContext()
+		sequence, err := simapp.GetSimApp(suite.chainA).ICQKeeper.SendQuery(ctx, path.EndpointA.ConnectionID, reqs, uint64(timeout), testCallbackID)
+		if err != nil {
+			return channeltypes.Packet{}, err
+		}
+		// commit the packet commitment, as no message was sent
+		suite.coordinator.CommitBlock(suite.chainA)
+
+		data, err := types.SerializeCosmosQuery(reqs)
+		suite.Require().NoError(err)
+		return channeltypes.NewPacket(
+			types.InterchainQueryPacketData{Data: data}.GetBytes(),
+			sequence,
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			path.EndpointB.ChannelConfig.PortID,
+			path.EndpointB.ChannelID,
+			clienttypes.ZeroHeight(),
+			uint64(ctx.BlockTime().Add(timeout).UnixNano()),
+		), nil
+	}
+
+	suite.Run("the responses are passed to the callback handler", func() {
+		setup()
+		packet, err := send(query(), time.Hour)
+		suite.Require().NoError(err)
+
+		suite.Require().NoError(path.RelayPacket(packet))
+		suite.Require().Len(results, 1)
+		suite.Require().Equal(path.EndpointA.ChannelID, results[0].channelID)
+		suite.Require().Equal(packet.GetSequence(), results[0].sequence)
+		suite.Require().NoError(results[0].err)
+		suite.Require().Len(results[0].response.Responses, 1)
+
+		var balances banktypes.QueryAllBalancesResponse
+		suite.Require().NoError(simapp.GetSimApp(suite.chainA).AppCodec().Unmarshal(results[0].response.Responses[0].Value, &balances))
+		suite.Require().False(balances.Balances.IsZero())
+
+		_, found := simapp.GetSimApp(suite.chainA).ICQKeeper.GetPendingQuery(suite.chainA.GetContext(), path.EndpointA.ChannelID, packet.GetSequence())
+		suite.Require().False(found)
+	})
+
+	suite.Run("an error acknowledgement is passed to the callback handler", func() {
+		setup()
+		reqs := query()
+		reqs[0].Path = "/cosmos.bank.v1beta1.Query/Balance"
+		packet, err := send(reqs, time.Hour)
+		suite.Require().NoError(err)
+
+		suite.Require().NoError(path.RelayPacket(packet))
+		suite.Require().Len(results, 1)
+		suite.Require().Nil(results[0].response)
+		suite.Require().ErrorIs(results[0].err, types.ErrQueryFailed)
+	})
+
+	suite.Run("a timeout is passed to the callback handler", func() {
+		setup()
+		packet, err := send(query(), time.Second)
+		suite.Require().NoError(err)
+
+		suite.coordinator.IncrementTimeBy(time.Minute)
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+		suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+		suite.Require().Len(results, 1)
+		suite.Require().Nil(results[0].response)
+		suite.Require().ErrorIs(results[0].err, types.ErrQueryTimeout)
+	})
+
+	storeQuery := func(denom string) []abcitypes.RequestQuery {
+		params := types.NewParams(true, []string{types.StoreQueryPath(banktypes.StoreKey)})
+		suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+
+		key, err := collections.EncodeKeyWithPrefix(
+			banktypes.BalancesPrefix,
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey),
+			collections.Join(suite.chainB.SenderAccount.GetAddress(), denom),
+		)
+		suite.Require().NoError(err)
+		return []abcitypes.RequestQuery{{
+			Path:  types.StoreQueryPath(banktypes.StoreKey),
+			Data:  key,
+			Prove: true,
+		}}
+	}
+
+	suite.Run("the proof of a store query is verified", func() {
+		setup()
+		packet, err := send(storeQuery(sdk.DefaultBondDenom), time.Hour)
+		suite.Require().NoError(err)
+
+		suite.Require().NoError(relayProvenQuery(path, packet))
+		suite.Require().Len(results, 1)
+		suite.Require().NoError(results[0].err)
+		suite.Require().Len(results[0].response.Responses, 1)
+		suite.Require().NotEmpty(results[0].response.Responses[0].Value)
+		suite.Require().NotNil(results[0].response.Responses[0].ProofOps)
+	})
+
+	suite.Run("the proof of absence of a key is verified", func() {
+		setup()
+		// the absent key is the left neighbor of the balance of the sender, as ICS-23 cannot prove the absence of a
+		// key next to an empty value, such as the ones of the denom to address index of the bank store
+		packet, err := send(storeQuery("absent"), time.Hour)
+		suite.Require().NoError(err)
+
+		suite.Require().NoError(relayProvenQuery(path, packet))
+		suite.Require().Len(results, 1)
+		suite.Require().NoError(results[0].err)
+		suite.Require().Empty(results[0].response.Responses[0].Value)
+	})
+
+	suite.Run("the acknowledgement is relayed again once the client has the consensus state of its height", func() {
+		setup()
+		packet, err := send(storeQuery(sdk.DefaultBondDenom), time.Hour)
+		suite.Require().NoError(err)
+
+		ack, err := recvQuery(path, packet)
+		suite.Require().NoError(err)
+		updateMsg, err := latestHeaderUpdate(path)
+		suite.Require().NoError(err)
+
+		// the client skips the height proving the response, so the acknowledgement fails
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+		err = path.EndpointA.AcknowledgePacket(packet, ack)
+		suite.Require().ErrorContains(err, clienttypes.ErrConsensusStateNotFound.Error())
+		suite.Require().Empty(results)
+		_, found := simapp.GetSimApp(suite.chainA).ICQKeeper.GetPendingQuery(suite.chainA.GetContext(), path.EndpointA.ChannelID, packet.GetSequence())
+		suite.Require().True(found)
+
+		// until the client is updated with the header of that height
+		_, err = suite.chainA.SendMsgs(updateMsg)
+		suite.Require().NoError(err)
+		suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ack))
+		suite.Require().Len(results, 1)
+		suite.Require().NoError(results[0].err)
+		suite.Require().NotEmpty(results[0].response.Responses[0].Value)
+	})
+
+	suite.Run("a response served at another height than requested is rejected", func() {
+		setup()
+		reqs := query()
+		reqs[0].Height = suite.chainB.GetContext().BlockHeight() - 1
+		packet, err := send(reqs, time.Hour)
+		suite.Require().NoError(err)
+
+		bz, err := types.SerializeCosmosResponse([]abcitypes.ResponseQuery{{Value: []byte("balance"), Height: reqs[0].Height + 1}})
+		suite.Require().NoError(err)
+		ackData, err := types.ModuleCdc.MarshalJSON(&types.InterchainQueryPacketAck{Data: bz})
+		suite.Require().NoError(err)
+
+		ack := channeltypes.NewResultAcknowledgement(ackData).Acknowledgement()
+		err = simapp.GetSimApp(suite.chainA).ICQKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, ack)
+		suite.Require().NoError(err)
+		suite.Require().Len(results, 1)
+		suite.Require().Nil(results[0].response)
+		suite.Require().ErrorIs(results[0].err, types.ErrInvalidProof)
+	})
+
+	suite.Run("a response not matching its proof is rejected", func() {
+		setup()
+		reqs := storeQuery(sdk.DefaultBondDenom)
+		packet, err := send(reqs, time.Hour)
+		suite.Require().NoError(err)
+
+		// prove the balance at the height of the latest header of the client, then forge the value
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+		storeQuerier := simapp.GetSimApp(suite.chainB).CommitMultiStore().(storetypes.Queryable)
+		res, err := storeQuerier.Query(&storetypes.RequestQuery{
+			Path:   "/" + banktypes.StoreKey + "/key",
+			Data:   reqs[0].Data,
+			Height: int64(suite.chainB.LatestCommittedHeader.GetHeight().GetRevisionHeight()) - 1,
+			Prove:  true,
+		})
+		suite.Require().NoError(err)
+		bz, err := types.SerializeCosmosResponse([]abcitypes.ResponseQuery{{
+			Key:      res.Key,
+			Value:    []byte("1000000000000000000"),
+			ProofOps: res.ProofOps,
+			Height:   res.Height,
+		}})
+		suite.Require().NoError(err)
+		ackData, err := types.ModuleCdc.MarshalJSON(&types.InterchainQueryPacketAck{Data: bz})
+		suite.Require().NoError(err)
+
+		ack := channeltypes.NewResultAcknowledgement(ackData).Acknowledgement()
+		err = simapp.GetSimApp(suite.chainA).ICQKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, ack)
+		suite.Require().NoError(err)
+		suite.Require().Len(results, 1)
+		suite.Require().Nil(results[0].response)
+		suite.Require().ErrorIs(results[0].err, types.ErrInvalidProof)
+	})
+
+	suite.Run("a proven response served below the height of the client when the query was sent is rejected", func() {
+		setup()
+		reqs := storeQuery(sdk.DefaultBondDenom)
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+		staleHeight := int64(suite.chainB.LatestCommittedHeader.GetHeight().GetRevisionHeight()) - 1
+		suite.coordinator.CommitBlock(suite.chainB)
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+		packet, err := send(reqs, time.Hour)
+		suite.Require().NoError(err)
+
+		pendingQuery, found := simapp.GetSimApp(suite.chainA).ICQKeeper.GetPendingQuery(suite.chainA.GetContext(), path.EndpointA.ChannelID, packet.GetSequence())
+		suite.Require().True(found)
+		suite.Require().Equal(suite.chainB.LatestCommittedHeader.GetHeight().GetRevisionHeight(), pendingQuery.MinProofHeight)
+
+		// the response is proven by a consensus state of the client, but at a height older than the query
+		storeQuerier := simapp.GetSimApp(suite.chainB).CommitMultiStore().(storetypes.Queryable)
+		res, err := storeQuerier.Query(&storetypes.RequestQuery{
+			Path:   "/" + banktypes.StoreKey + "/key",
+			Data:   reqs[0].Data,
+			Height: staleHeight,
+			Prove:  true,
+		})
+		suite.Require().NoError(err)
+		bz, err := types.SerializeCosmosResponse([]abcitypes.ResponseQuery{{
+			Key:      res.Key,
+			Value:    res.Value,
+			ProofOps: res.ProofOps,
+			Height:   res.Height,
+		}})
+		suite.Require().NoError(err)
+		ackData, err := types.ModuleCdc.MarshalJSON(&types.InterchainQueryPacketAck{Data: bz})
+		suite.Require().NoError(err)
+
+		ack := channeltypes.NewResultAcknowledgement(ackData).Acknowledgement()
+		err = simapp.GetSimApp(suite.chainA).ICQKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, ack)
+		suite.Require().NoError(err)
+		suite.Require().Len(results, 1)
+		suite.Require().Nil(results[0].response)
+		suite.Require().ErrorIs(results[0].err, types.ErrInvalidProof)
+		suite.Require().ErrorContains(results[0].err, "below the height")
+	})
+
+	suite.Run("the host only proves store queries", func() {
+		setup()
+		reqs := query()
+		reqs[0].Prove = true
+		packet, err := send(reqs, time.Hour)
+		suite.Require().NoError(err)
+
+		suite.Require().NoError(path.RelayPacket(packet))
+		suite.Require().Len(results, 1)
+		suite.Require().ErrorIs(results[0].err, types.ErrQueryFailed)
+	})
+
+	suite.Run("unknown callback id", func() {
+		setup()
+		_, err := simapp.GetSimApp(suite.chainA).ICQKeeper.SendQuery(suite.chainA.GetContext(), path.EndpointA.ConnectionID, query(), uint64(time.Hour), "unknown")
+		suite.Require().ErrorIs(err, types.ErrCallbackNotFound)
+	})
+
+	suite.Run("no active channel on the connection", func() {
+		setup()
+		_, err := simapp.GetSimApp(suite.chainA).ICQKeeper.SendQuery(suite.chainA.GetContext(), "connection-9", query(), uint64(time.Hour), testCallbackID)
+		suite.Require().ErrorIs(err, types.ErrActiveChannelNotFound)
+	})
+
+	suite.Run("a second controller channel cannot be opened on the connection", func() {
+		setup()
+		path2 := NewICQControllerPath(suite.chainA, suite.chainB)
+		path2.EndpointA.ClientID, path2.EndpointA.ConnectionID = path.EndpointA.ClientID, path.EndpointA.ConnectionID
+		path2.EndpointB.ClientID, path2.EndpointB.ConnectionID = path.EndpointB.ClientID, path.EndpointB.ConnectionID
+		suite.Require().Error(path2.EndpointA.ChanOpenInit())
+	})
+}
+
+func (suite *KeeperTestSuite) TestMsgSendQuery() {
+	suite.SetupTest()
+
+	path := NewICQControllerPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.Require().NoError(SetupICQPath(path))
+
+	params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.Query/AllBalances"})
+	suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+
+	icqKeeper := simapp.GetSimApp(suite.chainA).ICQKeeper
+	q := banktypes.QueryAllBalancesRequest{Address: suite.chainB.SenderAccount.GetAddress().String()}
+	reqs := []abcitypes.RequestQuery{{
+		Path: "/cosmos.bank.v1beta1.Query/AllBalances",
+		Data: simapp.GetSimApp(suite.chainA).AppCodec().MustMarshal(&q),
+	}}
+	sender := suite.chainA.SenderAccount.GetAddress().String()
+	msg := types.NewMsgSendQuery(sender, path.EndpointA.ChannelID, reqs, uint64(time.Hour))
+	suite.Require().NoError(msg.ValidateBasic())
+
+	ctx := suite.chainA.GetContext()
+	res, err := keeper.NewMsgServerImpl(icqKeeper).SendQuery(ctx, msg)
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	result, found := icqKeeper.GetQueryResult(suite.chainA.GetContext(), path.EndpointA.ChannelID, res.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(sender, result.Sender)
+	suite.Require().Equal(types.QueryStatusPending, result.Status)
+
+	data, err := types.SerializeCosmosQuery(reqs)
+	suite.Require().NoError(err)
+	packet := channeltypes.NewPacket(
+		types.InterchainQueryPacketData{Data: data}.GetBytes(),
+		res.Sequence,
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		clienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().Add(time.Hour).UnixNano()),
+	)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	queryRes, err := icqKeeper.QueryResult(suite.chainA.GetContext(), &types.QueryQueryResultRequest{
+		ChannelId: path.EndpointA.ChannelID,
+		Sequence:  res.Sequence,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.QueryStatusSuccess, queryRes.Result.Status)
+	suite.Require().Len(queryRes.Result.Responses, 1)
+	suite.Require().Len(queryRes.DecodedResponses, 1)
+
+	var balances banktypes.QueryAllBalancesResponse
+	suite.Require().NoError(simapp.GetSimApp(suite.chainA).AppCodec().UnmarshalJSON([]byte(queryRes.DecodedResponses[0]), &balances))
+	suite.Require().False(balances.Balances.IsZero())
+}
@@ -1,11 +1,13 @@
 package keeper
 
 import (
-	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"strconv"
+
+	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
 // EmitWriteErrorAcknowledgementEvent emits an event signalling an error acknowledgement and including the error details
@@ -19,3 +21,30 @@ func EmitWriteErrorAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI
 		),
 	)
 }
+
+// EmitWriteClientErrorAcknowledgementEvent emits an event signalling an error acknowledgement of an IBC v2 packet
+// received on a host client and including the error details
+func EmitWriteClientErrorAcknowledgementEvent(ctx sdk.Context, clientID string, err error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icqtypes.EventTypePacketError,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icqtypes.ModuleName),
+			sdk.NewAttribute(icqtypes.AttributeKeyAckError, err.Error()),
+			sdk.NewAttribute(icqtypes.AttributeKeyHostClientID, clientID),
+		),
+	)
+}
+
+// EmitCallbackErrorEvent emits an event signalling the failure of the callback handler of a query
+func EmitCallbackErrorEvent(ctx sdk.Context, callbackID, channelID string, sequence uint64, err error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icqtypes.EventTypeCallbackError,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icqtypes.ModuleName),
+			sdk.NewAttribute(icqtypes.AttributeKeyCallbackID, callbackID),
+			sdk.NewAttribute(icqtypes.AttributeKeyControllerChannelID, channelID),
+			sdk.NewAttribute(icqtypes.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(icqtypes.AttributeKeyAckError, err.Error()),
+		),
+	)
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// GetFeeEscrow returns the fee escrow of the depositor for the host channel, else for the host client
+func (k Keeper) GetFeeEscrow(ctx sdk.Context, channelID, clientID, depositor string) (types.FeeEscrow, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeeEscrowKey(channelID, clientID, depositor))
+	if bz == nil {
+		return types.FeeEscrow{}, false
+	}
+
+	var feeEscrow types.FeeEscrow
+	k.cdc.MustUnmarshal(bz, &feeEscrow)
+	return feeEscrow, true
+}
+
+// SetFeeEscrow stores a fee escrow, deleting it when its amount is zero
+func (k Keeper) SetFeeEscrow(ctx sdk.Context, feeEscrow types.FeeEscrow) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FeeEscrowKey(feeEscrow.ChannelId, feeEscrow.ClientId, feeEscrow.Depositor)
+	if feeEscrow.Amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&feeEscrow))
+}
+
+// GetAllFeeEscrows returns the fee escrows of the host channels and clients
+func (k Keeper) GetAllFeeEscrows(ctx sdk.Context) []types.FeeEscrow {
+	var feeEscrows []types.FeeEscrow
+	for _, prefix := range [][]byte{types.ChannelFeeEscrowKeyPrefix, types.ClientFeeEscrowKeyPrefix} {
+		iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			var feeEscrow types.FeeEscrow
+			k.cdc.MustUnmarshal(iterator.Value(), &feeEscrow)
+			feeEscrows = append(feeEscrows, feeEscrow)
+		}
+		iterator.Close()
+	}
+	return feeEscrows
+}
+
+// FundFeeEscrow transfers the amount from the depositor to the module account, to pay the query fees of the host
+// channel, else of the host client, for the queries of the payer. The payer replaces the one of the escrow.
+func (k Keeper) FundFeeEscrow(ctx sdk.Context, depositor sdk.AccAddress, channelID, clientID, payer string, amount sdk.Coins) error {
+	if channelID != "" {
+		if _, found := k.channelKeeper.GetChannel(ctx, k.GetPort(ctx), channelID); !found {
+			return errors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", k.GetPort(ctx), channelID)
+		}
+	} else if k.clientKeeper.GetClientStatus(ctx, clientID) == ibcexported.Unknown {
+		return errors.Wrapf(clienttypes.ErrClientNotFound, "client ID (%s)", clientID)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	feeEscrow, _ := k.GetFeeEscrow(ctx, channelID, clientID, depositor.String())
+	k.SetFeeEscrow(ctx, types.FeeEscrow{
+		ChannelId: channelID,
+		ClientId:  clientID,
+		Depositor: depositor.String(),
+		Payer:     payer,
+		Amount:    feeEscrow.Amount.Add(amount...),
+	})
+	return nil
+}
+
+// WithdrawFeeEscrow refunds the balance of the fee escrow of the depositor for the host channel, else for the host
+// client, to the depositor
+func (k Keeper) WithdrawFeeEscrow(ctx sdk.Context, depositor sdk.AccAddress, channelID, clientID string) (sdk.Coins, error) {
+	feeEscrow, found := k.GetFeeEscrow(ctx, channelID, clientID, depositor.String())
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "fee escrow %s%s of %s", channelID, clientID, depositor)
+	}
+
+	k.SetFeeEscrow(ctx, types.FeeEscrow{ChannelId: channelID, ClientId: clientID, Depositor: depositor.String()})
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, feeEscrow.Amount); err != nil {
+		return nil, err
+	}
+	return feeEscrow.Amount, nil
+}
+
+// chargeQueryFee pays the fees of the query requests in the fee table of the params from the fee escrow named by the
+// memo of the packet data to the fee recipient, and returns them. Nothing is charged when they are free. The maximum
+// fee of the memo must cover them, and the escrow must pay for the queries of the payer of the memo.
+func (k Keeper) chargeQueryFee(ctx sdk.Context, channelID, clientID, memo string, params types.Params, reqs []abci.RequestQuery) (sdk.Coins, error) {
+	paths := make([]string, len(reqs))
+	for i, req := range reqs {
+		paths[i] = req.Path
+	}
+	required := types.RequiredQueryFee(params.QueryFees, paths)
+	if required.IsZero() {
+		return nil, nil
+	}
+
+	fee, found, err := types.ParseFeeMemo(memo)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.Wrapf(types.ErrInsufficientFee, "no fee, required %s", required)
+	}
+	if !fee.MaxFee.IsAllGTE(required) {
+		return nil, errors.Wrapf(types.ErrInsufficientFee, "max fee %s, required %s", fee.MaxFee, required)
+	}
+	feeEscrow, found := k.GetFeeEscrow(ctx, channelID, clientID, fee.Escrow)
+	if !found {
+		return nil, errors.Wrapf(types.ErrInsufficientFee, "no fee escrow %s%s of %s", channelID, clientID, fee.Escrow)
+	}
+	if feeEscrow.Payer != "" && feeEscrow.Payer != fee.Payer {
+		return nil, errors.Wrapf(types.ErrInvalidFee, "fee escrow of %s doesn't pay for the queries of %s", fee.Escrow, fee.Payer)
+	}
+	remaining, negative := feeEscrow.Amount.SafeSub(required...)
+	if negative {
+		return nil, errors.Wrapf(types.ErrInsufficientFee, "required fee %s exceeds the fee escrow %s of %s", required, feeEscrow.Amount, fee.Escrow)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(params.FeeRecipient)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidFee, "invalid fee recipient: %s", err)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, required); err != nil {
+		return nil, err
+	}
+	feeEscrow.Amount = remaining
+	k.SetFeeEscrow(ctx, feeEscrow)
+	return required, nil
+}
@@ -0,0 +1,255 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (suite *KeeperTestSuite) TestQueryFees() {
+	var (
+		path      *ibctesting.Path
+		sender    sdk.AccAddress
+		depositor sdk.AccAddress
+		recipient sdk.AccAddress
+	)
+
+	denom := sdk.DefaultBondDenom
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+	allBalances := "/cosmos.bank.v1beta1.Query/AllBalances"
+
+	setup := func() {
+		suite.SetupTest()
+
+		path = NewICQControllerPath(suite.chainA, suite.chainB)
+		suite.coordinator.SetupConnections(path)
+		suite.Require().NoError(SetupICQPath(path))
+
+		sender = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		depositor = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		recipient = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		suite.Require().NoError(simapp.GetSimApp(suite.chainB).BankKeeper.SendCoins(
+			suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), depositor, coins(100),
+		))
+
+		params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.Query/*"})
+		params.QueryFees = []types.QueryFee{{Path: allBalances, Fee: coins(5)}}
+		params.FeeRecipient = recipient.String()
+		suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+	}
+
+	balance := func(addr sdk.AccAddress) sdk.Coins {
+		return simapp.GetSimApp(suite.chainB).BankKeeper.GetAllBalances(suite.chainB.GetContext(), addr)
+	}
+
+	fund := func(payer string, amount sdk.Coins) error {
+		msg := types.NewMsgFundFeeEscrow(depositor.String(), path.EndpointB.ChannelID, payer, amount)
+		_, err := keeper.NewMsgServerImpl(simapp.GetSimApp(suite.chainB).ICQKeeper).FundFeeEscrow(suite.chainB.GetContext(), msg)
+		return err
+	}
+
+	escrowed := func() sdk.Coins {
+		feeEscrow, _ := simapp.GetSimApp(suite.chainB).ICQKeeper.GetFeeEscrow(suite.chainB.GetContext(), path.EndpointB.ChannelID, "", depositor.String())
+		return feeEscrow.Amount
+	}
+
+	send := func(fee sdk.Coins) channeltypes.Packet {
+		q := banktypes.QueryAllBalancesRequest{Address: suite.chainB.SenderAccount.GetAddress().String()}
+		reqs := []abcitypes.RequestQuery{{
+			Path: allBalances,
+			Data: simapp.GetSimApp(suite.chainA).AppCodec().MustMarshal(&q),
+		}}
+		msg := types.NewMsgSendQuery(sender.String(), path.EndpointA.ChannelID, reqs, uint64(time.Hour))
+		msg.Fee = fee
+		msg.FeeEscrow = depositor.String()
+		suite.Require().NoError(msg.ValidateBasic())
+
+		ctx := suite.chainA.GetContext()
+		res, err := keeper.NewMsgServerImpl(simapp.GetSimApp(suite.chainA).ICQKeeper).SendQuery(ctx, msg)
+		suite.Require().NoError(err)
+		suite.coordinator.CommitBlock(suite.chainA)
+
+		data, err := types.SerializeCosmosQuery(reqs)
+		suite.Require().NoError(err)
+		memo := types.NewFeeMemo(types.FeeMemo{Escrow: depositor.String(), Payer: sender.String(), MaxFee: fee})
+		return channeltypes.NewPacket(
+			types.InterchainQueryPacketData{Data: data, Memo: memo}.GetBytes(),
+			res.Sequence,
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			path.EndpointB.ChannelConfig.PortID,
+			path.EndpointB.ChannelID,
+			clienttypes.ZeroHeight(),
+			uint64(ctx.BlockTime().Add(time.Hour).UnixNano()),
+		)
+	}
+
+	status := func(packet channeltypes.Packet) types.QueryStatus {
+		result, found := simapp.GetSimApp(suite.chainA).ICQKeeper.GetQueryResult(suite.chainA.GetContext(), packet.GetSourceChannel(), packet.GetSequence())
+		suite.Require().True(found)
+		return result.Status
+	}
+
+	suite.Run("the host charges the required fee from the fee escrow paying for the sender", func() {
+		setup()
+		suite.Require().NoError(fund(sender.String(), coins(20)))
+		suite.Require().Equal(coins(80), balance(depositor))
+
+		res, err := simapp.GetSimApp(suite.chainB).ICQKeeper.FeeEscrow(suite.chainB.GetContext(), &types.QueryFeeEscrowRequest{
+			ChannelId: path.EndpointB.ChannelID,
+			Depositor: depositor.String(),
+		})
+		suite.Require().NoError(err)
+		feeEscrow := types.FeeEscrow{
+			ChannelId: path.EndpointB.ChannelID,
+			Depositor: depositor.String(),
+			Payer:     sender.String(),
+			Amount:    coins(20),
+		}
+		suite.Require().Equal(feeEscrow, res.FeeEscrow)
+		suite.Require().Equal([]types.FeeEscrow{feeEscrow}, simapp.GetSimApp(suite.chainB).ICQKeeper.ExportGenesis(suite.chainB.GetContext()).FeeEscrows)
+
+		packet := send(coins(8))
+		suite.Require().NoError(path.RelayPacket(packet))
+		suite.Require().Equal(types.QueryStatusSuccess, status(packet))
+		suite.Require().Equal(coins(5), balance(recipient))
+		suite.Require().Equal(coins(15), escrowed())
+	})
+
+	suite.Run("the query fails when the fee escrow pays for another sender", func() {
+		setup()
+		other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		suite.Require().NoError(fund(other.String(), coins(20)))
+
+		packet := send(coins(8))
+		suite.Require().NoError(path.RelayPacket(packet))
+		suite.Require().Equal(types.QueryStatusFailed, status(packet))
+		suite.Require().True(balance(recipient).IsZero())
+		suite.Require().Equal(coins(20), escrowed())
+	})
+
+	suite.Run("the fee escrow pays for any sender without a payer", func() {
+		setup()
+		suite.Require().NoError(fund("", coins(20)))
+
+		packet := send(coins(5))
+		suite.Require().NoError(path.RelayPacket(packet))
+		suite.Require().Equal(types.QueryStatusSuccess, status(packet))
+		suite.Require().Equal(coins(5), balance(recipient))
+		suite.Require().Equal(coins(15), escrowed())
+	})
+
+	suite.Run("only the depositor withdraws the fee escrow", func() {
+		setup()
+		suite.Require().NoError(fund(sender.String(), coins(20)))
+		msgServer := keeper.NewMsgServerImpl(simapp.GetSimApp(suite.chainB).ICQKeeper)
+		ctx := suite.chainB.GetContext()
+
+		other := suite.chainB.SenderAccount.GetAddress().String()
+		_, err := msgServer.WithdrawFeeEscrow(ctx, types.NewMsgWithdrawFeeEscrow(other, path.EndpointB.ChannelID, ""))
+		suite.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+		res, err := msgServer.WithdrawFeeEscrow(ctx, types.NewMsgWithdrawFeeEscrow(depositor.String(), path.EndpointB.ChannelID, ""))
+		suite.Require().NoError(err)
+		suite.Require().Equal(coins(20), res.Amount)
+		suite.Require().Equal(coins(100), balance(depositor))
+		suite.Require().True(escrowed().IsZero())
+		suite.Require().Empty(simapp.GetSimApp(suite.chainB).ICQKeeper.GetAllFeeEscrows(ctx))
+	})
+
+	suite.Run("the fee escrow of an unknown channel or client cannot be funded", func() {
+		setup()
+		msgServer := keeper.NewMsgServerImpl(simapp.GetSimApp(suite.chainB).ICQKeeper)
+		ctx := suite.chainB.GetContext()
+
+		_, err := msgServer.FundFeeEscrow(ctx, types.NewMsgFundFeeEscrow(depositor.String(), "channel-9", "", coins(20)))
+		suite.Require().ErrorIs(err, channeltypes.ErrChannelNotFound)
+		_, err = msgServer.FundFeeEscrow(ctx, types.NewMsgFundClientFeeEscrow(depositor.String(), "07-tendermint-9", "", coins(20)))
+		suite.Require().ErrorIs(err, clienttypes.ErrClientNotFound)
+		suite.Require().Equal(coins(100), balance(depositor))
+
+		_, err = msgServer.FundFeeEscrow(ctx, types.NewMsgFundClientFeeEscrow(depositor.String(), path.EndpointB.ClientID, "", coins(20)))
+		suite.Require().NoError(err)
+		feeEscrow, found := simapp.GetSimApp(suite.chainB).ICQKeeper.GetFeeEscrow(ctx, "", path.EndpointB.ClientID, depositor.String())
+		suite.Require().True(found)
+		suite.Require().Equal(coins(20), feeEscrow.Amount)
+	})
+
+	suite.Run("the host reports the fee it charges in the response", func() {
+		setup()
+		suite.Require().NoError(fund(sender.String(), coins(12)))
+		app := simapp.GetSimApp(suite.chainB)
+		ctx := suite.chainB.GetContext()
+		recv := func(queryPath, memo string) (types.CosmosResponse, error) {
+			req := abcitypes.RequestQuery{Path: queryPath}
+			if queryPath == allBalances {
+				q := banktypes.QueryAllBalancesRequest{Address: suite.chainB.SenderAccount.GetAddress().String()}
+				req.Data = app.AppCodec().MustMarshal(&q)
+			}
+			data, err := types.SerializeCosmosQuery([]abcitypes.RequestQuery{req})
+			suite.Require().NoError(err)
+			packet := channeltypes.NewPacket(
+				types.InterchainQueryPacketData{Data: data, Memo: memo}.GetBytes(),
+				1,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(1, 100),
+				0,
+			)
+			bz, err := app.ICQKeeper.OnRecvPacket(ctx, packet)
+			if err != nil {
+				return types.CosmosResponse{}, err
+			}
+			var ack types.InterchainQueryPacketAck
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &ack))
+			return types.DeserializeCosmosResponseWithFee(ack.Data)
+		}
+		feeMemo := func(escrow string, maxFee sdk.Coins) string {
+			return types.NewFeeMemo(types.FeeMemo{Escrow: escrow, Payer: sender.String(), MaxFee: maxFee})
+		}
+
+		// queries without a fee in the table are free
+		resp, err := recv("/cosmos.bank.v1beta1.Query/TotalSupply", "")
+		suite.Require().NoError(err)
+		suite.Require().True(resp.Fee.IsZero())
+
+		_, err = recv(allBalances, "")
+		suite.Require().ErrorIs(err, types.ErrInsufficientFee)
+		_, err = recv(allBalances, feeMemo(depositor.String(), coins(3)))
+		suite.Require().ErrorIs(err, types.ErrInsufficientFee)
+		_, err = recv(allBalances, feeMemo(recipient.String(), coins(8)))
+		suite.Require().ErrorIs(err, types.ErrInsufficientFee)
+		_, err = recv(allBalances, `{"fee":{"escrow":"cosmos1","max_fee":"5stake"}}`)
+		suite.Require().ErrorIs(err, types.ErrInvalidFee)
+		suite.Require().True(balance(recipient).IsZero())
+
+		// only the required fee is charged, until the escrow cannot cover it
+		resp, err = recv(allBalances, feeMemo(depositor.String(), coins(8)))
+		suite.Require().NoError(err)
+		suite.Require().Equal(coins(5), resp.Fee)
+		suite.Require().Len(resp.Responses, 1)
+		_, err = recv(allBalances, feeMemo(depositor.String(), coins(8)))
+		suite.Require().NoError(err)
+		suite.Require().Equal(coins(2), escrowed())
+		_, err = recv(allBalances, feeMemo(depositor.String(), coins(8)))
+		suite.Require().ErrorIs(err, types.ErrInsufficientFee)
+		suite.Require().Equal(coins(10), balance(recipient))
+	})
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// executeWithGasLimit runs fn with a child gas meter limited to gasLimit, or to the gas left to the parent gas meter
+// if it is lower, and charges the gas used to the parent gas meter. Running out of the gas limit returns
+// ErrMaxGasExceeded, while running out of the gas of the parent gas meter panics like in the normal tx execution
+// flow. A gasLimit of 0 runs fn with the parent gas meter.
+func executeWithGasLimit(ctx sdk.Context, gasLimit uint64, descriptor string, fn func(ctx sdk.Context) error) (err error) {
+	if gasLimit == 0 {
+		return fn(ctx)
+	}
+
+	limit := gasLimit
+	if parent := ctx.GasMeter(); parent.GasRemaining() < limit {
+		limit = parent.GasRemaining()
+	}
+	gasMeter := storetypes.NewGasMeter(limit)
+
+	defer func() {
+		r := recover()
+		// the gas consumed past the limit is charged to the parent gas meter, so that it runs out of gas if the
+		// child gas meter was limited by it
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumed(), descriptor)
+		if r == nil {
+			return
+		}
+		if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+			panic(r)
+		}
+		err = errors.Wrapf(types.ErrMaxGasExceeded, "%s gas limit %d", descriptor, gasLimit)
+	}()
+
+	return fn(ctx.WithGasMeter(gasMeter))
+}
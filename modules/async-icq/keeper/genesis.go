@@ -3,35 +3,56 @@ package keeper
 import (
 	"fmt"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// InitGenesis initializes the icq state and binds to PortID.
+// InitGenesis initializes the icq state and sets the host and controller ports, which the app routes to the module.
 func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	k.SetPort(ctx, state.HostPort)
 
-	// Only try to bind to port if it is not already bound, since we may already own
-	// port capability from capability InitGenesis
-	if !k.IsBound(ctx, state.HostPort) {
-		// transfer module binds to the transfer port on InitChain
-		// and claims the returned capability
-		err := k.BindPort(ctx, state.HostPort)
-		if err != nil {
-			panic(fmt.Sprintf("could not claim port capability: %v", err))
-		}
+	if state.ControllerPort != "" {
+		k.SetControllerPort(ctx, state.ControllerPort)
 	}
 
 	if err := k.SetParams(ctx, state.Params); err != nil {
 		panic(fmt.Sprintf("could not set params: %v", err))
 	}
+
+	for _, override := range state.AllowlistOverrides {
+		if err := k.SetAllowlistOverride(ctx, override); err != nil {
+			panic(fmt.Sprintf("could not set allowlist override: %v", err))
+		}
+	}
+
+	for _, activeChannel := range state.ActiveChannels {
+		k.SetActiveChannelID(ctx, activeChannel.ConnectionId, activeChannel.ChannelId)
+	}
+
+	for _, pendingQuery := range state.PendingQueries {
+		k.SetPendingQuery(ctx, pendingQuery)
+	}
+
+	for _, result := range state.QueryResults {
+		k.SetQueryResult(ctx, result)
+	}
+
+	for _, feeEscrow := range state.FeeEscrows {
+		k.SetFeeEscrow(ctx, feeEscrow)
+	}
 }
 
-// ExportGenesis exports icq module's portID and denom trace info into its genesis state.
+// ExportGenesis exports the ports, params and host and controller state of the icq module into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		HostPort: k.GetPort(ctx),
-		Params:   k.GetParams(ctx),
+		HostPort:           k.GetPort(ctx),
+		Params:             k.GetParams(ctx),
+		ControllerPort:     k.GetControllerPort(ctx),
+		AllowlistOverrides: k.GetAllAllowlistOverrides(ctx),
+		ActiveChannels:     k.GetAllActiveChannels(ctx),
+		PendingQueries:     k.GetAllPendingQueries(ctx),
+		QueryResults:       k.GetAllQueryResults(ctx),
+		FeeEscrows:         k.GetAllFeeEscrows(ctx),
 	}
 }
@@ -1,8 +1,8 @@
 package keeper_test
 
 import (
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/testing/simapp"
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 )
 
 func (suite *KeeperTestSuite) TestInitGenesis() {
@@ -45,3 +45,47 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	expParams := types.DefaultParams()
 	suite.Require().Equal(expParams, genesisState.GetParams())
 }
+
+func (suite *KeeperTestSuite) TestGenesisControllerState() {
+	suite.SetupTest()
+	ctx := suite.chainA.GetContext()
+	icqKeeper := simapp.GetSimApp(suite.chainA).ICQKeeper
+
+	icqKeeper.SetActiveChannelID(ctx, "connection-0", "channel-1")
+	pendingQueries := []types.PendingQuery{
+		{ChannelId: "channel-1", Sequence: 1, CallbackId: types.ModuleName},
+		{ChannelId: "channel-1", Sequence: 256, CallbackId: testCallbackID, MinProofHeight: 10},
+	}
+	for _, pendingQuery := range pendingQueries {
+		icqKeeper.SetPendingQuery(ctx, pendingQuery)
+	}
+	pendingResult := types.QueryResult{
+		Sender:    suite.chainA.SenderAccount.GetAddress().String(),
+		ChannelId: "channel-1",
+		Sequence:  1,
+		Paths:     []string{"/cosmos.bank.v1beta1.Query/AllBalances"},
+		Status:    types.QueryStatusPending,
+	}
+	icqKeeper.SetQueryResult(ctx, pendingResult)
+
+	exported := icqKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(exported.Validate())
+	suite.Require().Equal([]types.ActiveChannel{{ConnectionId: "connection-0", ChannelId: "channel-1"}}, exported.ActiveChannels)
+	suite.Require().Equal(pendingQueries, exported.PendingQueries)
+	suite.Require().Equal([]types.QueryResult{pendingResult}, exported.QueryResults)
+
+	// the controller state is imported as exported on another chain
+	ctxB := suite.chainB.GetContext()
+	icqKeeperB := simapp.GetSimApp(suite.chainB).ICQKeeper
+	icqKeeperB.InitGenesis(ctxB, *exported)
+
+	channelID, found := icqKeeperB.GetActiveChannelID(ctxB, "connection-0")
+	suite.Require().True(found)
+	suite.Require().Equal("channel-1", channelID)
+	pendingQuery, found := icqKeeperB.GetPendingQuery(ctxB, "channel-1", 256)
+	suite.Require().True(found)
+	suite.Require().Equal(pendingQueries[1], pendingQuery)
+	result, found := icqKeeperB.GetQueryResult(ctxB, "channel-1", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(pendingResult, result)
+}
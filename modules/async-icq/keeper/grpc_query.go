@@ -3,8 +3,11 @@ package keeper
 import (
 	"context"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -19,3 +22,82 @@ func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// QueryResult implements the Query/QueryResult gRPC method
+func (q Keeper) QueryResult(c context.Context, req *types.QueryQueryResultRequest) (*types.QueryQueryResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	result, found := q.GetQueryResult(ctx, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no query result for channel %s sequence %d", req.ChannelId, req.Sequence)
+	}
+
+	decoded := make([]string, len(result.Responses))
+	for i, resp := range result.Responses {
+		if resp.Code != 0 || i >= len(result.Paths) {
+			continue
+		}
+		_, msg, err := types.NewQueryMessages(result.Paths[i])
+		if err != nil {
+			continue
+		}
+		if err := q.cdc.Unmarshal(resp.Value, msg); err != nil {
+			continue
+		}
+		bz, err := codec.ProtoMarshalJSON(msg, nil)
+		if err != nil {
+			continue
+		}
+		decoded[i] = string(bz)
+	}
+
+	return &types.QueryQueryResultResponse{
+		Result:           result,
+		DecodedResponses: decoded,
+	}, nil
+}
+
+// EffectiveAllowlist implements the Query/EffectiveAllowlist gRPC method
+func (q Keeper) EffectiveAllowlist(c context.Context, req *types.QueryEffectiveAllowlistRequest) (*types.QueryEffectiveAllowlistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if (req.ChannelId == "") == (req.ClientId == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of the channel and the client must be set")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	allowQueries, source := q.GetEffectiveAllowQueries(ctx, req.ChannelId)
+	if req.ClientId != "" {
+		allowQueries, source = q.GetEffectiveClientAllowQueries(ctx, req.ClientId)
+	}
+
+	return &types.QueryEffectiveAllowlistResponse{
+		AllowQueries: allowQueries,
+		Source:       source,
+	}, nil
+}
+
+// FeeEscrow implements the Query/FeeEscrow gRPC method
+func (q Keeper) FeeEscrow(c context.Context, req *types.QueryFeeEscrowRequest) (*types.QueryFeeEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateFeeEscrowTarget(req.ChannelId, req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	feeEscrow, found := q.GetFeeEscrow(ctx, req.ChannelId, req.ClientId, req.Depositor)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no fee escrow %s%s of %s", req.ChannelId, req.ClientId, req.Depositor)
+	}
+
+	return &types.QueryFeeEscrowResponse{
+		FeeEscrow: feeEscrow,
+	}, nil
+}
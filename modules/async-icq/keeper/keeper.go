@@ -3,7 +3,7 @@ package keeper
 import (
 	"fmt"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -12,24 +12,27 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
-	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
-	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
-	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
-// Keeper defines the IBC interchain query host keeper
+// Keeper defines the IBC interchain query keeper, which handles both the host and the controller ends of the
+// interchain query channels
 type Keeper struct {
 	storeKey storetypes.StoreKey
 	cdc      codec.BinaryCodec
 
 	ics4Wrapper   types.ICS4Wrapper
 	channelKeeper types.ChannelKeeper
-	portKeeper    types.PortKeeper
-
-	scopedKeeper capabilitykeeper.ScopedKeeper
+	clientKeeper  types.ClientKeeper
+	bankKeeper    types.BankKeeper
 
 	queryRouter *baseapp.GRPCQueryRouter
+	// storeQuerier serves the raw store queries with their proofs. The host rejects them when it is nil.
+	storeQuerier types.StoreQuerier
+
+	// callbackHandlers are the handlers of the results of the queries sent by the controller, keyed by module name
+	callbackHandlers map[string]types.QueryCallbackHandler
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -39,19 +42,26 @@ type Keeper struct {
 // NewKeeper creates a new interchain query Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey,
-	ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper,
-	scopedKeeper capabilitykeeper.ScopedKeeper, queryRouter *baseapp.GRPCQueryRouter, authority string,
+	ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper, clientKeeper types.ClientKeeper,
+	bankKeeper types.BankKeeper, queryRouter *baseapp.GRPCQueryRouter,
+	storeQuerier types.StoreQuerier, authority string,
 ) Keeper {
-	return Keeper{
+	k := Keeper{
 		storeKey:      key,
 		cdc:           cdc,
 		ics4Wrapper:   ics4Wrapper,
 		channelKeeper: channelKeeper,
-		portKeeper:    portKeeper,
-		scopedKeeper:  scopedKeeper,
+		clientKeeper:  clientKeeper,
+		bankKeeper:    bankKeeper,
 		queryRouter:   queryRouter,
+		storeQuerier:  storeQuerier,
 		authority:     authority,
+
+		callbackHandlers: make(map[string]types.QueryCallbackHandler),
 	}
+	// the results of the queries sent with MsgSendQuery are stored by the module
+	k.RegisterCallbackHandler(types.ModuleName, queryResultHandler{k})
+	return k
 }
 
 // Logger returns the application logger, scoped to the associated module
@@ -59,18 +69,6 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", ibcexported.ModuleName, types.ModuleName))
 }
 
-// BindPort stores the provided portID and binds to it, returning the associated capability
-func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
-	capability := k.portKeeper.BindPort(ctx, portID)
-	return k.ClaimCapability(ctx, capability, host.PortPath(portID))
-}
-
-// IsBound checks if the interchain query already bound to the desired port
-func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
-	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
-	return ok
-}
-
 // GetPort returns the portID for the transfer module. Used in ExportGenesis
 func (k Keeper) GetPort(ctx sdk.Context) string {
 	store := ctx.KVStore(k.storeKey)
@@ -83,16 +81,6 @@ func (k Keeper) SetPort(ctx sdk.Context, portID string) {
 	store.Set(types.PortKey, []byte(portID))
 }
 
-// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
-func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
-	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
-}
-
-// ClaimCapability wraps the scopedKeeper's ClaimCapability function
-func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
-	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
-}
-
 // GetAppVersion calls the ICS4Wrapper GetAppVersion function.
 func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
 	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
@@ -102,3 +90,8 @@ func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
+
+// GetChannel returns the channel end of the port and channel.
+func (k Keeper) GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool) {
+	return k.channelKeeper.GetChannel(ctx, portID, channelID)
+}
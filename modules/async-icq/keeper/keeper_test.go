@@ -3,12 +3,12 @@ package keeper_test
 import (
 	"testing"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/testing/simapp"
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 	"github.com/stretchr/testify/suite"
 
-	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
-	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 )
 
 var TestPort = "icq-test"
@@ -66,15 +66,17 @@ func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
 
-func (suite *KeeperTestSuite) TestIsBound() {
+func (suite *KeeperTestSuite) TestPortRoutes() {
 	suite.SetupTest()
 
-	path := NewICQPath(suite.chainA, suite.chainB)
-	suite.coordinator.SetupConnections(path)
+	icqKeeper := simapp.GetSimApp(suite.chainB).ICQKeeper
+	ctx := suite.chainB.GetContext()
+	suite.Require().Equal(types.PortID, icqKeeper.GetPort(ctx))
+	suite.Require().Equal(types.ControllerPortID, icqKeeper.GetControllerPort(ctx))
 
-	err := SetupICQPath(path)
-	suite.Require().NoError(err)
-
-	isBound := simapp.GetSimApp(suite.chainB).ICQKeeper.IsBound(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID)
-	suite.Require().True(isBound)
+	// the host and controller ports set in genesis are routed to the module
+	for _, portID := range []string{icqKeeper.GetPort(ctx), icqKeeper.GetControllerPort(ctx)} {
+		_, found := suite.chainB.App.GetIBCKeeper().PortKeeper.Route(portID)
+		suite.Require().True(found, portID)
+	}
 }
@@ -1,8 +1,9 @@
 package keeper
 
 import (
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/exported"
-	v2 "github.com/cosmos/ibc-apps/modules/async-icq/v8/migrations/v2"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/exported"
+	v2 "github.com/cosmos/ibc-apps/modules/async-icq/v10/migrations/v2"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -27,3 +28,20 @@ func NewMigrator(k *Keeper, ss exported.Subspace) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates the module state from the consensus version 2 to
+// version 3. Specifically, it sets the default packet limits and contract
+// callback gas limit of the params and sets the default controller port.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.MaxRequestsPerPacket = types.DefaultMaxRequestsPerPacket
+	params.MaxGasPerPacket = types.DefaultMaxGasPerPacket
+	params.MaxResponseBytes = types.DefaultMaxResponseBytes
+	params.ContractCallbackGasLimit = types.DefaultContractCallbackGasLimit
+	if err := m.keeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	m.keeper.SetControllerPort(ctx, types.ControllerPortID)
+	return nil
+}
@@ -3,7 +3,7 @@ package keeper
 import (
 	"context"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 
 	"cosmossdk.io/errors"
 
@@ -35,3 +35,69 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (ms msgServer) SendQuery(goCtx context.Context, msg *types.MsgSendQuery) (*types.MsgSendQueryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sequence, err := ms.SendUserQuery(ctx, msg.Sender, msg.ChannelId, msg.Requests, msg.Timeout, msg.ContractCallback, msg.FeeEscrow, msg.Fee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendQueryResponse{Sequence: sequence}, nil
+}
+
+func (ms msgServer) SetAllowlistOverride(goCtx context.Context, req *types.MsgSetAllowlistOverride) (*types.MsgSetAllowlistOverrideResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.SetAllowlistOverride(ctx, req.Override); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAllowlistOverrideResponse{}, nil
+}
+
+func (ms msgServer) RemoveAllowlistOverride(goCtx context.Context, req *types.MsgRemoveAllowlistOverride) (*types.MsgRemoveAllowlistOverrideResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := ms.GetAllowlistOverride(ctx, req.ChannelId, req.ConnectionId, req.ClientId); !found {
+		return nil, errors.Wrapf(types.ErrAllowlistNotFound, "channel %q connection %q client %q", req.ChannelId, req.ConnectionId, req.ClientId)
+	}
+	ms.DeleteAllowlistOverride(ctx, req.ChannelId, req.ConnectionId, req.ClientId)
+
+	return &types.MsgRemoveAllowlistOverrideResponse{}, nil
+}
+
+func (ms msgServer) FundFeeEscrow(goCtx context.Context, msg *types.MsgFundFeeEscrow) (*types.MsgFundFeeEscrowResponse, error) {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.FundFeeEscrow(ctx, depositor, msg.ChannelId, msg.ClientId, msg.Payer, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundFeeEscrowResponse{}, nil
+}
+
+func (ms msgServer) WithdrawFeeEscrow(goCtx context.Context, msg *types.MsgWithdrawFeeEscrow) (*types.MsgWithdrawFeeEscrowResponse, error) {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	amount, err := ms.Keeper.WithdrawFeeEscrow(ctx, depositor, msg.ChannelId, msg.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawFeeEscrowResponse{Amount: amount}, nil
+}
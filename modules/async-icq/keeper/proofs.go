@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// merkleRootConsensusState is a consensus state committing to the app hash of the host chain, such as the one of
+// the tendermint light client
+type merkleRootConsensusState interface {
+	GetRoot() ibcexported.Root
+}
+
+// verifyQueryProofs checks that the responses to the queries sent at a given height were served at that height, and
+// verifies the proofs of the store queries sent with Prove set against the consensus state of the counterparty client
+// of the channel. The proven responses to the queries sent without a height must be served at or above
+// minProofHeight, the latest height of the client when the query was sent. A store query served at height h is proven by the app hash of the header at height h+1: if the
+// client is not active or has no consensus state at that height yet, ErrClientNotActive or ErrConsensusStateNotFound
+// is returned, and the acknowledgement can be relayed again once the client is updated.
+func (k Keeper) verifyQueryProofs(ctx sdk.Context, packet channeltypes.Packet, resps []abci.ResponseQuery, minProofHeight uint64) error {
+	var data types.InterchainQueryPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return errors.Wrapf(types.ErrUnknownDataType, "cannot unmarshal ICQ packet data")
+	}
+	reqs, err := types.DeserializeCosmosQuery(data.GetData())
+	if err != nil {
+		return err
+	}
+
+	var (
+		clientID     string
+		latestHeight ibcexported.Height
+	)
+	for i, req := range reqs {
+		if req.Height != 0 && i < len(resps) && resps[i].Height != req.Height {
+			return errors.Wrapf(types.ErrInvalidProof, "response to query %d served at height %d instead of %d", i, resps[i].Height, req.Height)
+		}
+		if !req.Prove {
+			continue
+		}
+		storeName, ok := types.ParseStoreQueryPath(req.Path)
+		if !ok {
+			return errors.Wrapf(types.ErrInvalidProof, "query %d is not a store query", i)
+		}
+		if i >= len(resps) {
+			return errors.Wrapf(types.ErrInvalidProof, "no response to query %d", i)
+		}
+		resp := resps[i]
+		if resp.ProofOps == nil || resp.Height <= 0 {
+			return errors.Wrapf(types.ErrInvalidProof, "no proof in the response to query %d", i)
+		}
+		if req.Height == 0 && uint64(resp.Height) < minProofHeight {
+			return errors.Wrapf(types.ErrInvalidProof, "response to query %d served at height %d below the height %d of the client when it was sent", i, resp.Height, minProofHeight)
+		}
+
+		if latestHeight == nil {
+			clientID, _, err = k.channelKeeper.GetChannelClientState(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+			if err != nil {
+				return err
+			}
+			if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != ibcexported.Active {
+				return errors.Wrapf(clienttypes.ErrClientNotActive, "client %s is not active: %s", clientID, status)
+			}
+			latestHeight = k.clientKeeper.GetClientLatestHeight(ctx, clientID)
+		}
+
+		height := clienttypes.NewHeight(latestHeight.GetRevisionNumber(), uint64(resp.Height)+1)
+		consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientID, height)
+		if !found {
+			return errors.Wrapf(clienttypes.ErrConsensusStateNotFound, "no consensus state of client %s at height %s", clientID, height)
+		}
+		rootConsensusState, ok := consensusState.(merkleRootConsensusState)
+		if !ok {
+			return errors.Wrapf(types.ErrInvalidProof, "consensus state of client %s has no merkle root", clientID)
+		}
+
+		proof, err := commitmenttypes.ConvertProofs(resp.ProofOps)
+		if err != nil {
+			return errors.Wrapf(types.ErrInvalidProof, "query %d: %s", i, err)
+		}
+		path := commitmenttypesv2.NewMerklePath([]byte(storeName), req.Data)
+		if len(resp.Value) == 0 {
+			err = proof.VerifyNonMembership(commitmenttypes.GetSDKSpecs(), rootConsensusState.GetRoot(), path)
+		} else {
+			err = proof.VerifyMembership(commitmenttypes.GetSDKSpecs(), rootConsensusState.GetRoot(), path, resp.Value)
+		}
+		if err != nil {
+			return errors.Wrapf(types.ErrInvalidProof, "query %d: %s", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+// SendUserQuery sends the query requests of MsgSendQuery on the controller channel, with the maximum fee paid from the
+// fee escrow of the depositor on the host, and stores a pending result for the sender. With contractCallback, the result is also delivered to the sender, which must be a contract.
+func (k Keeper) SendUserQuery(
+	ctx sdk.Context,
+	sender, channelID string,
+	reqs []abci.RequestQuery,
+	timeout uint64,
+	contractCallback bool,
+	feeEscrow string,
+	fee sdk.Coins,
+) (uint64, error) {
+	callbackID := types.ModuleName
+	if contractCallback {
+		callbackID = types.ContractCallbackID
+		handler, found := k.callbackHandlers[callbackID].(contractCallbackHandler)
+		if found && !handler.isContract(ctx, sender) {
+			return 0, errors.Wrapf(types.ErrSenderNotContract, "contract callback of %s", sender)
+		}
+	}
+	sequence, err := k.sendQuery(ctx, channelID, reqs, timeout, callbackID, types.FeeMemo{
+		Escrow: feeEscrow,
+		Payer:  sender,
+		MaxFee: fee,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	paths := make([]string, len(reqs))
+	for i, req := range reqs {
+		paths[i] = req.Path
+	}
+	k.SetQueryResult(ctx, types.QueryResult{
+		Sender:    sender,
+		ChannelId: channelID,
+		Sequence:  sequence,
+		Paths:     paths,
+		Status:    types.QueryStatusPending,
+	})
+	return sequence, nil
+}
+
+// GetQueryResult returns the result of the query sent on the channel with the sequence
+func (k Keeper) GetQueryResult(ctx sdk.Context, channelID string, sequence uint64) (types.QueryResult, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.QueryResultKey(channelID, sequence))
+	if bz == nil {
+		return types.QueryResult{}, false
+	}
+
+	var result types.QueryResult
+	k.cdc.MustUnmarshal(bz, &result)
+	return result, true
+}
+
+// SetQueryResult stores the result of a query sent with MsgSendQuery
+func (k Keeper) SetQueryResult(ctx sdk.Context, result types.QueryResult) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.QueryResultKey(result.ChannelId, result.Sequence), k.cdc.MustMarshal(&result))
+}
+
+// GetAllQueryResults returns the results of all the queries sent with MsgSendQuery
+func (k Keeper) GetAllQueryResults(ctx sdk.Context) []types.QueryResult {
+	var results []types.QueryResult
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.QueryResultKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.QueryResult
+		k.cdc.MustUnmarshal(iterator.Value(), &result)
+		results = append(results, result)
+	}
+	return results
+}
+
+// queryResultHandler stores the results of the queries sent with MsgSendQuery
+type queryResultHandler struct {
+	keeper Keeper
+}
+
+var _ types.QueryCallbackHandler = queryResultHandler{}
+
+func (h queryResultHandler) OnQueryResult(ctx sdk.Context, channelID string, sequence uint64, response *types.CosmosResponse, err error) error {
+	result, found := h.keeper.GetQueryResult(ctx, channelID, sequence)
+	if !found {
+		return errors.Wrapf(types.ErrInvalidQuery, "no query result for channel %s sequence %d", channelID, sequence)
+	}
+
+	switch {
+	case err == nil:
+		result.Status = types.QueryStatusSuccess
+		result.Responses = response.Responses
+	case errors.IsOf(err, types.ErrQueryTimeout):
+		result.Status = types.QueryStatusTimeout
+		result.Error = err.Error()
+	default:
+		result.Status = types.QueryStatusFailed
+		result.Error = err.Error()
+	}
+	h.keeper.SetQueryResult(ctx, result)
+	return nil
+}
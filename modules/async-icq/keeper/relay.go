@@ -1,24 +1,37 @@
 package keeper
 
 import (
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 
 	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
-	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 )
 
 // OnRecvPacket handles a given interchain queries packet on a destination host chain.
 // If the transaction is successfully executed, the transaction response bytes will be returned.
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+	return k.recvQuery(ctx, packet.GetData(), packet.GetDestChannel(), "", packet.GetSequence())
+}
+
+// OnRecvClientPacket handles the interchain queries packet data of an IBC v2 payload received on a host client. The
+// queries are executed as those of the packets received on a channel, with the allowlist of the client.
+func (k Keeper) OnRecvClientPacket(ctx sdk.Context, clientID string, sequence uint64, packetData []byte) ([]byte, error) {
+	return k.recvQuery(ctx, packetData, "", clientID, sequence)
+}
+
+// recvQuery executes the queries of the packet data received on a host channel, or on a host client if the channel
+// is empty, and returns the acknowledgement data
+func (k Keeper) recvQuery(ctx sdk.Context, packetData []byte, channelID, clientID string, sequence uint64) ([]byte, error) {
 	var data types.InterchainQueryPacketData
 
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	if err := types.ModuleCdc.UnmarshalJSON(packetData, &data); err != nil {
 		// UnmarshalJSON errors are indeterminate and therefore are not wrapped and included in failed acks
 		return nil, errors.Wrapf(types.ErrUnknownDataType, "cannot unmarshal ICQ packet data")
 	}
@@ -28,76 +41,177 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 		return nil, err
 	}
 
+	params := k.GetParams(ctx)
+	if params.MaxRequestsPerPacket > 0 && uint64(len(reqs)) > params.MaxRequestsPerPacket {
+		return nil, errors.Wrapf(types.ErrMaxRequestsExceeded, "%d requests, max %d", len(reqs), params.MaxRequestsPerPacket)
+	}
+	fee, err := k.chargeQueryFee(ctx, channelID, clientID, data.GetMemo(), params, reqs)
+	if err != nil {
+		return nil, err
+	}
+
 	// If we panic when executing a query it should be returned as an error.
-	var response []byte
+	var (
+		response   []byte
+		executions []queryExecution
+	)
 	err = applyFuncIfNoError(ctx, func(ctx sdk.Context) error {
-		response, err = k.executeQuery(ctx, reqs)
-		return err
+		return executeWithGasLimit(ctx, params.MaxGasPerPacket, "interchain query packet", func(ctx sdk.Context) error {
+			allowQueries, _ := k.effectiveAllowQueries(ctx, channelID, params)
+			if channelID == "" {
+				allowQueries, _ = k.effectiveClientAllowQueries(ctx, clientID, params)
+			}
+			response, executions, err = k.executeQuery(ctx, allowQueries, params, reqs, fee)
+			return err
+		})
 	})
 	if err != nil {
 		return nil, err
 	}
-	return response, err
+
+	if err := emitQueryExecuted(ctx, channelID, clientID, sequence, reqs, executions); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
-func (k Keeper) executeQuery(ctx sdk.Context, reqs []abci.RequestQuery) ([]byte, error) {
+// executeQuery executes the query requests, and returns the acknowledgement data, reporting the fee charged, with
+// the gas used by each request and the size of its response
+func (k Keeper) executeQuery(ctx sdk.Context, allowQueries []string, params types.Params, reqs []abci.RequestQuery, fee sdk.Coins) ([]byte, []queryExecution, error) {
 	resps := make([]abci.ResponseQuery, len(reqs))
+	executions := make([]queryExecution, len(reqs))
+	var responseBytes uint64
 	for i, req := range reqs {
-		if err := k.authenticateQuery(ctx, req); err != nil {
-			return nil, err
+		if err := k.authenticateQuery(ctx, allowQueries, req); err != nil {
+			k.incrDeniedQueries(req.Path)
+			return nil, nil, err
 		}
-
-		route := k.queryRouter.Route(req.Path)
-		if route == nil {
-			return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "no route found for: %s", req.Path)
+		gasBefore := ctx.GasMeter().GasConsumed()
+
+		var (
+			resp *abci.ResponseQuery
+			err  error
+		)
+		if storeName, ok := types.ParseStoreQueryPath(req.Path); ok {
+			resp, err = k.queryStore(ctx, storeName, req)
+		} else {
+			resp, err = k.queryRoute(ctx, req)
 		}
-
-		resp, err := route(ctx, &abci.RequestQuery{
-			Data: req.Data,
-			Path: req.Path,
-		})
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		// Remove non-deterministic fields from response
 		resps[i] = abci.ResponseQuery{
 			// Codespace is not currently part of consensus, but it will probablyy be added in the future
 			// Codespace: resp.Codespace,
-			Code:   resp.Code,
-			Index:  resp.Index,
-			Key:    resp.Key,
-			Value:  resp.Value,
-			Height: resp.Height,
+			Code:     resp.Code,
+			Index:    resp.Index,
+			Key:      resp.Key,
+			Value:    resp.Value,
+			ProofOps: resp.ProofOps,
+			Height:   resp.Height,
+		}
+
+		executions[i] = queryExecution{
+			gasUsed:      ctx.GasMeter().GasConsumed() - gasBefore,
+			responseSize: uint64(resps[i].Size()),
+		}
+		responseBytes += executions[i].responseSize
+		if params.MaxResponseBytes > 0 && responseBytes > params.MaxResponseBytes {
+			return nil, nil, errors.Wrapf(types.ErrMaxResponseBytesExceeded, "%d bytes after %d responses, max %d", responseBytes, i+1, params.MaxResponseBytes)
 		}
 	}
 
-	bz, err := types.SerializeCosmosResponse(resps)
+	bz, err := types.SerializeCosmosResponseWithFee(resps, fee)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	ack := types.InterchainQueryPacketAck{
 		Data: bz,
 	}
 	data, err := types.ModuleCdc.MarshalJSON(&ack)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to marshal tx data")
+		return nil, nil, errors.Wrap(err, "failed to marshal tx data")
 	}
 
-	return data, nil
+	return data, executions, nil
 }
 
-// authenticateQuery ensures the provided query request is in the whitelist.
-func (k Keeper) authenticateQuery(ctx sdk.Context, q abci.RequestQuery) error {
-	allowQueries := k.GetAllowQueries(ctx)
+// queryRoute executes a gRPC query with the query router at the current height, which is set in the response
+func (k Keeper) queryRoute(ctx sdk.Context, req abci.RequestQuery) (*abci.ResponseQuery, error) {
+	route := k.queryRouter.Route(req.Path)
+	if route == nil {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "no route found for: %s", req.Path)
+	}
+
+	resp, err := route(ctx, &abci.RequestQuery{
+		Data: req.Data,
+		Path: req.Path,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Height = ctx.BlockHeight()
+	return resp, nil
+}
+
+// queryStore queries the value of the key in the data of a raw store query, with its proof if requested. The store
+// is queried at the last committed height, so that the proof can be verified against the app hash of the header of
+// the current block.
+func (k Keeper) queryStore(ctx sdk.Context, storeName string, req abci.RequestQuery) (*abci.ResponseQuery, error) {
+	if k.storeQuerier == nil {
+		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "store queries are not supported")
+	}
+	height := ctx.BlockHeight() - 1
+	if height < 1 {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidHeight, "no committed height to query the store at")
+	}
+
+	res, err := k.storeQuerier.Query(&storetypes.RequestQuery{
+		Data:   req.Data,
+		Path:   "/" + storeName + "/key",
+		Height: height,
+		Prove:  req.Prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// charge the store read, including the proof, as it isn't metered by the committed store
+	gasConfig := storetypes.KVGasConfig()
+	size := len(res.Key) + len(res.Value)
+	if res.ProofOps != nil {
+		size += res.ProofOps.Size()
+	}
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostFlat+gasConfig.ReadCostPerByte*storetypes.Gas(size), "interchain store query")
+
+	return &abci.ResponseQuery{
+		Code:     res.Code,
+		Key:      res.Key,
+		Value:    res.Value,
+		ProofOps: res.ProofOps,
+		Height:   res.Height,
+	}, nil
+}
+
+// authenticateQuery ensures the provided query request is in the whitelist of the channel, and that it is served at
+// the current height. Historical queries are rejected, as the responses are part of consensus and the past state is
+// not available to every validator, such as the ones which were state synced.
+func (k Keeper) authenticateQuery(ctx sdk.Context, allowQueries []string, q abci.RequestQuery) error {
 	if !types.ContainsQueryPath(allowQueries, q.Path) {
 		return errors.Wrapf(sdkerrors.ErrUnauthorized, "query path not allowed: %s", q.Path)
 	}
 	if !(q.Height == 0 || q.Height == ctx.BlockHeight()) {
-		return errors.Wrapf(sdkerrors.ErrUnauthorized, "query height not allowed: %d", q.Height)
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "historical queries are not supported, query height not allowed: %d", q.Height)
+	}
+	// store queries are always served at the last committed height, which is set in their response
+	isStoreQuery := types.IsStoreQueryPath(q.Path)
+	if isStoreQuery && q.Height != 0 {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "store query height not allowed: %d", q.Height)
 	}
-	if q.Prove {
-		return errors.Wrapf(sdkerrors.ErrUnauthorized, "query proof not allowed")
+	if q.Prove && !isStoreQuery {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "query proof only allowed for store queries")
 	}
 
 	return nil
@@ -3,21 +3,23 @@ package keeper_test
 import (
 	"fmt"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/testing/simapp"
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 
+	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 
-	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
-	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
-	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 )
 
 func (suite *KeeperTestSuite) TestOnRecvPacket() {
@@ -154,6 +156,32 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			false,
 		},
+		{
+			"unauthorised: can not query a store at the current height",
+			func() {
+				reqs := []abcitypes.RequestQuery{
+					{
+						Path:   types.StoreQueryPath(banktypes.StoreKey),
+						Data:   banktypes.BalancesPrefix,
+						Height: suite.chainB.GetContext().BlockHeight(),
+						Prove:  true,
+					},
+				}
+				data, err := types.SerializeCosmosQuery(reqs)
+				suite.Require().NoError(err)
+
+				icqPacketData := types.InterchainQueryPacketData{
+					Data: data,
+				}
+				packetData = icqPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{types.StoreQueryPath(banktypes.StoreKey)})
+				if err := simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params); err != nil {
+					panic(err)
+				}
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -239,7 +267,7 @@ func (suite *KeeperTestSuite) TestOutOfGasOnSlowQueries() {
 	)
 
 	ctx := suite.chainB.GetContext()
-	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(2000))
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(10_000))
 	// enough gas for this small query, but not for the larger one. This one should work
 	_, err = simapp.GetSimApp(suite.chainB).ICQKeeper.OnRecvPacket(ctx, packet)
 	suite.Require().NoError(err)
@@ -275,7 +303,321 @@ func (suite *KeeperTestSuite) TestOutOfGasOnSlowQueries() {
 
 	// and this one should panic
 	suite.Assert().Panics(func() {
-		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(2000))
+		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(10_000))
 		_, _ = simapp.GetSimApp(suite.chainB).ICQKeeper.OnRecvPacket(ctx, packet)
 	}, "out of gas")
 }
+
+func (suite *KeeperTestSuite) TestPacketLimits() {
+	var params types.Params
+
+	newPacket := func(numRequests int) channeltypes.Packet {
+		q := banktypes.QueryAllBalancesRequest{Address: suite.chainB.SenderAccount.GetAddress().String()}
+		reqs := make([]abcitypes.RequestQuery, numRequests)
+		for i := range reqs {
+			reqs[i] = abcitypes.RequestQuery{
+				Path: "/cosmos.bank.v1beta1.Query/AllBalances",
+				Data: simapp.GetSimApp(suite.chainB).AppCodec().MustMarshal(&q),
+			}
+		}
+		data, err := types.SerializeCosmosQuery(reqs)
+		suite.Require().NoError(err)
+
+		return channeltypes.NewPacket(
+			types.InterchainQueryPacketData{Data: data}.GetBytes(),
+			1,
+			TestPort,
+			ibctesting.FirstChannelID,
+			types.PortID,
+			ibctesting.FirstChannelID,
+			clienttypes.NewHeight(1, 100),
+			0,
+		)
+	}
+
+	testCases := []struct {
+		msg         string
+		numRequests int
+		malleate    func()
+		expErr      error
+	}{
+		{
+			"success",
+			3,
+			func() {},
+			nil,
+		},
+		{
+			"too many requests",
+			3,
+			func() {
+				params.MaxRequestsPerPacket = 2
+			},
+			types.ErrMaxRequestsExceeded,
+		},
+		{
+			"gas limit exceeded",
+			3,
+			func() {
+				params.MaxGasPerPacket = 1000
+			},
+			types.ErrMaxGasExceeded,
+		},
+		{
+			"responses too large",
+			3,
+			func() {
+				params.MaxResponseBytes = 100
+			},
+			types.ErrMaxResponseBytesExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			params = types.DefaultParams()
+			params.AllowQueries = []string{"/cosmos.bank.v1beta1.Query/AllBalances"}
+			tc.malleate()
+			suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+
+			ctx := suite.chainB.GetContext()
+			gasBefore := ctx.GasMeter().GasConsumed()
+			response, err := simapp.GetSimApp(suite.chainB).ICQKeeper.OnRecvPacket(ctx, newPacket(tc.numRequests))
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(response)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(response)
+			}
+			// the gas used by the queries is charged to the relayer
+			suite.Require().Greater(ctx.GasMeter().GasConsumed(), gasBefore)
+		})
+	}
+
+	suite.Run("running out of the gas of the relayer panics", func() {
+		suite.SetupTest() // reset
+
+		params = types.DefaultParams()
+		params.AllowQueries = []string{"/cosmos.bank.v1beta1.Query/AllBalances"}
+		suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+
+		ctx := suite.chainB.GetContext().WithGasMeter(storetypes.NewGasMeter(5000))
+		suite.Require().Panics(func() {
+			_, _ = simapp.GetSimApp(suite.chainB).ICQKeeper.OnRecvPacket(ctx, newPacket(3))
+		})
+	})
+}
+
+func (suite *KeeperTestSuite) TestQueryHeights() {
+	var (
+		lastCommittedHeight int64
+		queryHeight         int64
+		queryPath           string
+	)
+
+	const balancePath = "/cosmos.bank.v1beta1.Query/Balance"
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expHeight func() int64
+		expMinted bool
+		expErr    error
+	}{
+		{
+			"query without height is served at the current height",
+			func() {},
+			func() int64 { return suite.chainB.GetContext().BlockHeight() },
+			true,
+			nil,
+		},
+		{
+			"query at the current height",
+			func() {
+				queryHeight = suite.chainB.GetContext().BlockHeight()
+			},
+			func() int64 { return queryHeight },
+			true,
+			nil,
+		},
+		{
+			"store query without height is served at the last committed height",
+			func() {
+				queryPath = types.StoreQueryPath(banktypes.StoreKey)
+			},
+			func() int64 { return lastCommittedHeight },
+			false,
+			nil,
+		},
+		{
+			"historical query",
+			func() {
+				queryHeight = lastCommittedHeight
+			},
+			nil,
+			false,
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"store query at a historical height",
+			func() {
+				queryPath = types.StoreQueryPath(banktypes.StoreKey)
+				queryHeight = lastCommittedHeight
+			},
+			nil,
+			false,
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"future height",
+			func() {
+				queryHeight = suite.chainB.GetContext().BlockHeight() + 1
+			},
+			nil,
+			false,
+			sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			app := simapp.GetSimApp(suite.chainB)
+			addr := suite.chainB.SenderAccount.GetAddress()
+			denom := sdk.DefaultBondDenom
+			balanceBefore := app.BankKeeper.GetBalance(suite.chainB.GetContext(), addr, denom)
+
+			// the state before the mint is the one committed at the previous height
+			lastCommittedHeight = suite.chainB.GetContext().BlockHeight() - 1
+			minted := sdk.NewInt64Coin(denom, 1000)
+			ctx := suite.chainB.GetContext()
+			suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(minted)))
+			suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, sdk.NewCoins(minted)))
+
+			queryHeight = 0
+			queryPath = balancePath
+			tc.malleate()
+			params := types.DefaultParams()
+			params.AllowQueries = []string{queryPath}
+			suite.Require().NoError(app.ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+
+			var err error
+			req := abcitypes.RequestQuery{Path: queryPath, Height: queryHeight}
+			if queryPath == balancePath {
+				req.Data = app.AppCodec().MustMarshal(&banktypes.QueryBalanceRequest{Address: addr.String(), Denom: denom})
+			} else {
+				req.Data, err = collections.EncodeKeyWithPrefix(
+					banktypes.BalancesPrefix,
+					collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey),
+					collections.Join(addr, denom),
+				)
+				suite.Require().NoError(err)
+			}
+			data, err := types.SerializeCosmosQuery([]abcitypes.RequestQuery{req})
+			suite.Require().NoError(err)
+			packet := channeltypes.NewPacket(
+				types.InterchainQueryPacketData{Data: data}.GetBytes(),
+				1,
+				TestPort,
+				ibctesting.FirstChannelID,
+				types.PortID,
+				ibctesting.FirstChannelID,
+				clienttypes.NewHeight(1, 100),
+				0,
+			)
+
+			ackBz, err := app.ICQKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(ackBz)
+				return
+			}
+			suite.Require().NoError(err)
+
+			var ack types.InterchainQueryPacketAck
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+			resps, err := types.DeserializeCosmosResponse(ack.Data)
+			suite.Require().NoError(err)
+			suite.Require().Len(resps, 1)
+			suite.Require().Equal(tc.expHeight(), resps[0].Height)
+
+			expBalance := balanceBefore
+			if tc.expMinted {
+				expBalance = balanceBefore.Add(minted)
+			}
+			if queryPath == balancePath {
+				var res banktypes.QueryBalanceResponse
+				suite.Require().NoError(app.AppCodec().Unmarshal(resps[0].Value, &res))
+				suite.Require().Equal(expBalance, *res.Balance)
+			} else {
+				amount, err := sdk.IntValue.Decode(resps[0].Value)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expBalance.Amount, amount)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEventQueryExecuted() {
+	suite.SetupTest()
+
+	params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.Query/*"})
+	suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+
+	q := banktypes.QueryAllBalancesRequest{Address: suite.chainB.SenderAccount.GetAddress().String()}
+	reqs := []abcitypes.RequestQuery{
+		{
+			Path: "/cosmos.bank.v1beta1.Query/AllBalances",
+			Data: simapp.GetSimApp(suite.chainB).AppCodec().MustMarshal(&q),
+		},
+		{
+			Path: "/cosmos.bank.v1beta1.Query/Params",
+		},
+	}
+	data, err := types.SerializeCosmosQuery(reqs)
+	suite.Require().NoError(err)
+	packet := channeltypes.NewPacket(
+		types.InterchainQueryPacketData{Data: data}.GetBytes(),
+		7,
+		TestPort,
+		ibctesting.FirstChannelID,
+		types.PortID,
+		ibctesting.FirstChannelID,
+		clienttypes.NewHeight(1, 100),
+		0,
+	)
+
+	ctx := suite.chainB.GetContext().WithEventManager(sdk.NewEventManager())
+	_, err = simapp.GetSimApp(suite.chainB).ICQKeeper.OnRecvPacket(ctx, packet)
+	suite.Require().NoError(err)
+
+	var event *types.EventQueryExecuted
+	for _, e := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(e)
+		if err != nil {
+			continue
+		}
+		if executed, ok := msg.(*types.EventQueryExecuted); ok {
+			event = executed
+		}
+	}
+	suite.Require().NotNil(event)
+	suite.Require().Equal(ibctesting.FirstChannelID, event.ChannelId)
+	suite.Require().Equal(uint64(7), event.Sequence)
+	suite.Require().Equal([]string{reqs[0].Path, reqs[1].Path}, event.Paths)
+	suite.Require().Len(event.GasUsed, 2)
+	suite.Require().Len(event.ResponseSizes, 2)
+	for i := range reqs {
+		suite.Require().NotZero(event.GasUsed[i])
+		suite.Require().NotZero(event.ResponseSizes[i])
+	}
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+	metrics "github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+const (
+	// labelQueryPath is the label of the query path of the host metrics
+	labelQueryPath = "path"
+	// unknownQueryPath is the query path label of the denied queries without a route, which would otherwise let the
+	// controllers create any number of labels
+	unknownQueryPath = "unknown"
+)
+
+var (
+	metricQueries       = []string{types.ModuleName, "host", "queries"}
+	metricQueryGas      = []string{types.ModuleName, "host", "query_gas"}
+	metricResponseBytes = []string{types.ModuleName, "host", "response_bytes"}
+	metricDeniedQueries = []string{types.ModuleName, "host", "denied_queries"}
+)
+
+// queryExecution is the gas used by a query request executed by the host, and the size of its response
+type queryExecution struct {
+	gasUsed      uint64
+	responseSize uint64
+}
+
+// emitQueryExecuted emits the EventQueryExecuted of a packet whose queries were executed, and records the number of
+// queries, their gas and the sizes of their responses by query path
+func emitQueryExecuted(ctx sdk.Context, channelID, clientID string, sequence uint64, reqs []abci.RequestQuery, executions []queryExecution) error {
+	event := types.EventQueryExecuted{
+		ChannelId:     channelID,
+		ClientId:      clientID,
+		Sequence:      sequence,
+		Paths:         make([]string, len(reqs)),
+		GasUsed:       make([]uint64, len(executions)),
+		ResponseSizes: make([]uint64, len(executions)),
+	}
+	for i, req := range reqs {
+		event.Paths[i] = req.Path
+	}
+	for i, execution := range executions {
+		event.GasUsed[i] = execution.gasUsed
+		event.ResponseSizes[i] = execution.responseSize
+
+		labels := []metrics.Label{telemetry.NewLabel(labelQueryPath, reqs[i].Path)}
+		telemetry.IncrCounterWithLabels(metricQueries, 1, labels)
+		metrics.AddSampleWithLabels(metricQueryGas, float32(execution.gasUsed), labels)
+		metrics.AddSampleWithLabels(metricResponseBytes, float32(execution.responseSize), labels)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&event)
+}
+
+// incrDeniedQueries counts a query denied by the host, by query path if the host can serve it
+func (k Keeper) incrDeniedQueries(path string) {
+	label := unknownQueryPath
+	if types.IsStoreQueryPath(path) || k.queryRouter.Route(path) != nil {
+		label = path
+	}
+	telemetry.IncrCounterWithLabels(metricDeniedQueries, 1, []metrics.Label{telemetry.NewLabel(labelQueryPath, label)})
+}
@@ -6,7 +6,7 @@ import (
 	"os"
 
 	dbm "github.com/cosmos/cosmos-db"
-	app "github.com/cosmos/ibc-apps/modules/async-icq/v8/testing/simapp"
+	app "github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+const (
+	// AllowlistSourceChannel is the source of the allowlist override of a host channel
+	AllowlistSourceChannel = "channel"
+	// AllowlistSourceConnection is the source of the allowlist override of a connection
+	AllowlistSourceConnection = "connection"
+	// AllowlistSourceClient is the source of the allowlist override of a host client
+	AllowlistSourceClient = "client"
+	// AllowlistSourceParams is the source of the allow_queries of the params
+	AllowlistSourceParams = "params"
+)
+
+// MatchQueryPath returns true if the query path is equal to the allowlist entry, or matches it as a glob pattern
+// (see path.Match). A '*' matches any part of a path segment, so /cosmos.bank.v1beta1.Query/* matches all the
+// methods of the bank query service, and /store/*/key all the raw store queries.
+func MatchQueryPath(pattern, queryPath string) bool {
+	if pattern == queryPath {
+		return true
+	}
+	matched, err := path.Match(pattern, queryPath)
+	return err == nil && matched
+}
+
+// ValidateQueryPattern checks that an allowlist entry is neither empty nor a malformed glob pattern
+func ValidateQueryPattern(pattern string) error {
+	if strings.TrimSpace(pattern) == "" {
+		return fmt.Errorf("empty query path")
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("invalid query path pattern %s: %w", pattern, err)
+	}
+	return nil
+}
+
+// NewAllowlistOverride creates a new AllowlistOverride of a host channel, or of a connection if the channel is empty
+func NewAllowlistOverride(channelID, connectionID string, allowQueries []string) AllowlistOverride {
+	return AllowlistOverride{
+		ChannelId:    channelID,
+		ConnectionId: connectionID,
+		AllowQueries: allowQueries,
+	}
+}
+
+// NewClientAllowlistOverride creates a new AllowlistOverride of a host client, for the IBC v2 packets received on it
+func NewClientAllowlistOverride(clientID string, allowQueries []string) AllowlistOverride {
+	return AllowlistOverride{
+		ClientId:     clientID,
+		AllowQueries: allowQueries,
+	}
+}
+
+// Validate performs basic validation of the AllowlistOverride
+func (o AllowlistOverride) Validate() error {
+	if err := ValidateAllowlistTarget(o.ChannelId, o.ConnectionId, o.ClientId); err != nil {
+		return err
+	}
+	for _, pattern := range o.AllowQueries {
+		if err := ValidateQueryPattern(pattern); err != nil {
+			return errors.Wrap(ErrInvalidAllowlist, err.Error())
+		}
+	}
+	return nil
+}
+
+// ValidateAllowlistTarget checks that exactly one of the channel, the connection and the client of an allowlist
+// override is set, and that it is a valid identifier
+func ValidateAllowlistTarget(channelID, connectionID, clientID string) error {
+	set := 0
+	for _, id := range []string{channelID, connectionID, clientID} {
+		if id != "" {
+			set++
+		}
+	}
+	switch {
+	case set > 1:
+		return errors.Wrap(ErrInvalidAllowlist, "only one of the channel, the connection and the client can be set")
+	case channelID != "":
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return errors.Wrap(err, "invalid channel id")
+		}
+	case connectionID != "":
+		if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+			return errors.Wrap(err, "invalid connection id")
+		}
+	case clientID != "":
+		if err := host.ClientIdentifierValidator(clientID); err != nil {
+			return errors.Wrap(err, "invalid client id")
+		}
+	default:
+		return errors.Wrap(ErrInvalidAllowlist, "either the channel, the connection or the client must be set")
+	}
+	return nil
+}
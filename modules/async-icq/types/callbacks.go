@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueryCallbackHandler is implemented by the modules sending interchain queries with the controller keeper. The
+// handler is registered with the keeper under the module name, which is the callback ID passed to SendQuery.
+type QueryCallbackHandler interface {
+	// OnQueryResult is called with the responses of the host when the query is acknowledged. When the query fails
+	// or times out, the response is nil and the error wraps ErrQueryFailed or ErrQueryTimeout.
+	//
+	// The state changes of the handler are discarded if it returns an error.
+	OnQueryResult(ctx sdk.Context, channelID string, sequence uint64, response *CosmosResponse, err error) error
+}
@@ -44,10 +44,30 @@ func DeserializeCosmosResponse(bz []byte) (resps []abcitypes.ResponseQuery, err
 	return r.Responses, err
 }
 
+// SerializeCosmosResponseWithFee serializes the responses with the fee charged by the host
+func SerializeCosmosResponseWithFee(resps []abcitypes.ResponseQuery, fee sdk.Coins) (bz []byte, err error) {
+	r := &CosmosResponse{
+		Responses: resps,
+		Fee:       fee,
+	}
+	return ModuleCdc.Marshal(r)
+}
+
+// DeserializeCosmosResponseWithFee deserializes the responses with the fee charged by the host
+func DeserializeCosmosResponseWithFee(bz []byte) (r CosmosResponse, err error) {
+	err = ModuleCdc.Unmarshal(bz, &r)
+	return r, err
+}
+
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSendQuery{},
+		&MsgSetAllowlistOverride{},
+		&MsgRemoveAllowlistOverride{},
+		&MsgFundFeeEscrow{},
+		&MsgWithdrawFeeEscrow{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
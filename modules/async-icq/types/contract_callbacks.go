@@ -0,0 +1,40 @@
+package types
+
+// ContractCallbackID is the callback ID of the queries sent by CosmWasm contracts with MsgSendQuery and
+// contract_callback set. Their results are delivered to the contracts with sudo messages.
+const ContractCallbackID = "wasm"
+
+// ICQResponseSudoMsg is the sudo message sent to a contract when its query is acknowledged
+type ICQResponseSudoMsg struct {
+	ICQResponse ICQResponse `json:"icq_response"`
+}
+
+// ICQResponse is the result of an acknowledged query. The responses are in the order of the query requests. When the
+// host acknowledged the query with an error, or the proof of a response is invalid, success is false and the error
+// is set instead.
+type ICQResponse struct {
+	Channel   string                  `json:"channel"`
+	Sequence  uint64                  `json:"sequence"`
+	Success   bool                    `json:"success"`
+	Responses []ContractQueryResponse `json:"responses,omitempty"`
+	Error     string                  `json:"error,omitempty"`
+}
+
+// ContractQueryResponse is the response of the host to a query request. The bytes are encoded in base64.
+type ContractQueryResponse struct {
+	Code   uint32 `json:"code"`
+	Key    []byte `json:"key,omitempty"`
+	Value  []byte `json:"value,omitempty"`
+	Height int64  `json:"height"`
+}
+
+// ICQTimeoutSudoMsg is the sudo message sent to a contract when its query times out
+type ICQTimeoutSudoMsg struct {
+	ICQTimeout ICQTimeout `json:"icq_timeout"`
+}
+
+// ICQTimeout identifies the query that timed out
+type ICQTimeout struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+}
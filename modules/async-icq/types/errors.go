@@ -10,4 +10,21 @@ var (
 	ErrInvalidHostPort    = sdkerrors.Register(ModuleName, 3, "invalid host port")
 	ErrHostDisabled       = sdkerrors.Register(ModuleName, 4, "host is disabled")
 	ErrInvalidVersion     = sdkerrors.Register(ModuleName, 5, "invalid version")
+
+	ErrInvalidControllerPort    = sdkerrors.Register(ModuleName, 6, "invalid controller port")
+	ErrActiveChannelNotFound    = sdkerrors.Register(ModuleName, 7, "no active channel for this connection")
+	ErrActiveChannelAlreadySet  = sdkerrors.Register(ModuleName, 8, "active channel already set for this connection")
+	ErrCallbackNotFound         = sdkerrors.Register(ModuleName, 9, "no callback handler registered")
+	ErrInvalidQuery             = sdkerrors.Register(ModuleName, 10, "invalid query")
+	ErrQueryFailed              = sdkerrors.Register(ModuleName, 11, "interchain query failed")
+	ErrQueryTimeout             = sdkerrors.Register(ModuleName, 12, "interchain query timed out")
+	ErrInvalidProof             = sdkerrors.Register(ModuleName, 13, "invalid query proof")
+	ErrInvalidAllowlist         = sdkerrors.Register(ModuleName, 14, "invalid allowlist")
+	ErrAllowlistNotFound        = sdkerrors.Register(ModuleName, 15, "allowlist override not found")
+	ErrMaxRequestsExceeded      = sdkerrors.Register(ModuleName, 16, "too many query requests in packet")
+	ErrMaxGasExceeded           = sdkerrors.Register(ModuleName, 17, "packet query gas limit exceeded")
+	ErrMaxResponseBytesExceeded = sdkerrors.Register(ModuleName, 18, "packet query responses too large")
+	ErrInvalidFee               = sdkerrors.Register(ModuleName, 19, "invalid query fee")
+	ErrInsufficientFee          = sdkerrors.Register(ModuleName, 20, "insufficient query fee")
+	ErrSenderNotContract        = sdkerrors.Register(ModuleName, 21, "sender is not a contract")
 )
@@ -2,8 +2,13 @@ package types
 
 // ICQ Interchain Query events
 const (
-	EventTypePacketError = "icq_packet_error"
+	EventTypePacketError   = "icq_packet_error"
+	EventTypeCallbackError = "icq_callback_error"
 
-	AttributeKeyAckError      = "error"
-	AttributeKeyHostChannelID = "host_channel_id"
+	AttributeKeyAckError            = "error"
+	AttributeKeyHostChannelID       = "host_channel_id"
+	AttributeKeyHostClientID        = "host_client_id"
+	AttributeKeyControllerChannelID = "controller_channel_id"
+	AttributeKeyCallbackID          = "callback_id"
+	AttributeKeySequence            = "sequence"
 )
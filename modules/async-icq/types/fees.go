@@ -0,0 +1,159 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/cosmos/ibc-apps/modules/memo"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// FeeMemoKey is the key of the fee in the memo of the packet data
+const FeeMemoKey = "fee"
+
+// FeeMemo is the fee section of the memo of the packet data. It names the fee escrow of the host channel paying for
+// the queries of the packet, and the sender of the queries on the controller chain, which the escrow must pay for.
+type FeeMemo struct {
+	// Escrow is the depositor of the fee escrow, an account of the host chain
+	Escrow string `json:"escrow"`
+	// Payer is the sender of the queries on the controller chain
+	Payer string `json:"payer"`
+	// MaxFee is the maximum fee the host may charge, in the denoms of the host
+	MaxFee sdk.Coins `json:"max_fee"`
+}
+
+// NewFeeMemo returns the memo carrying the fee section, or an empty memo if the maximum fee is zero
+func NewFeeMemo(fee FeeMemo) string {
+	if fee.MaxFee.IsZero() {
+		return ""
+	}
+	bz, err := json.Marshal(map[string]FeeMemo{FeeMemoKey: fee})
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+// ParseFeeMemo returns the fee section of the memo of the packet data, and whether the memo has one. A memo that
+// isn't a JSON object has no fee section.
+func ParseFeeMemo(memoString string) (FeeMemo, bool, error) {
+	parsed, err := memo.Parse(memoString)
+	if err != nil {
+		return FeeMemo{}, false, nil
+	}
+	section, found := parsed.Raw(FeeMemoKey)
+	if !found {
+		return FeeMemo{}, false, nil
+	}
+	fee, err := parseFeeSection(section)
+	return fee, true, err
+}
+
+// RegisterMemoSections registers the memo key owned by async-icq ("fee") in the registry shared by the middlewares
+// of the interchain query stack.
+func RegisterMemoSections(registry *memo.Registry) error {
+	return registry.Register(FeeMemoKey, ModuleName, func(section json.RawMessage) error {
+		_, err := parseFeeSection(section)
+		return err
+	})
+}
+
+func parseFeeSection(section json.RawMessage) (FeeMemo, error) {
+	var fee FeeMemo
+	if err := json.Unmarshal(section, &fee); err != nil {
+		return FeeMemo{}, errors.Wrapf(ErrInvalidFee, "memo fee: %s", err)
+	}
+	if err := fee.Validate(); err != nil {
+		return FeeMemo{}, errors.Wrapf(ErrInvalidFee, "memo fee: %s", err)
+	}
+	return fee, nil
+}
+
+// Validate performs basic validation of the fee section of a memo. The fee escrow is an address of the host chain,
+// so only its bech32 encoding is checked.
+func (fm FeeMemo) Validate() error {
+	if _, _, err := bech32.DecodeAndConvert(fm.Escrow); err != nil {
+		return fmt.Errorf("invalid fee escrow: %w", err)
+	}
+	if strings.TrimSpace(fm.Payer) == "" {
+		return fmt.Errorf("payer must be set")
+	}
+	if err := fm.MaxFee.Validate(); err != nil {
+		return fmt.Errorf("invalid max fee: %w", err)
+	}
+	if fm.MaxFee.IsZero() {
+		return fmt.Errorf("max fee must be positive")
+	}
+	return nil
+}
+
+// RequiredQueryFee returns the fee of query requests with the paths: the sum of the fees of the first entry of the
+// fee table matching each path
+func RequiredQueryFee(queryFees []QueryFee, paths []string) sdk.Coins {
+	fee := sdk.NewCoins()
+	for _, path := range paths {
+		for _, queryFee := range queryFees {
+			if MatchQueryPath(queryFee.Path, path) {
+				fee = fee.Add(queryFee.Fee...)
+				break
+			}
+		}
+	}
+	return fee
+}
+
+// Validate performs basic validation of the fee of a query path
+func (qf QueryFee) Validate() error {
+	if err := ValidateQueryPattern(qf.Path); err != nil {
+		return err
+	}
+	if err := qf.Fee.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidFee, "fee of %s: %s", qf.Path, err)
+	}
+	if qf.Fee.IsZero() {
+		return errors.Wrapf(ErrInvalidFee, "fee of %s must be positive", qf.Path)
+	}
+	return nil
+}
+
+// ValidateFeeEscrowTarget validates the host channel or client of a fee escrow, exactly one of which is set
+func ValidateFeeEscrowTarget(channelID, clientID string) error {
+	switch {
+	case channelID != "" && clientID != "":
+		return errors.Wrap(ErrInvalidFee, "only one of the channel and the client of the fee escrow can be set")
+	case channelID != "":
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return errors.Wrap(err, "invalid channel id")
+		}
+	case clientID != "":
+		if err := host.ClientIdentifierValidator(clientID); err != nil {
+			return errors.Wrap(err, "invalid client id")
+		}
+	default:
+		return errors.Wrap(ErrInvalidFee, "either the channel or the client of the fee escrow must be set")
+	}
+	return nil
+}
+
+// Validate performs basic validation of the fee escrow of a host channel or client
+func (fe FeeEscrow) Validate() error {
+	if err := ValidateFeeEscrowTarget(fe.ChannelId, fe.ClientId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(fe.Depositor); err != nil {
+		return errors.Wrapf(ErrInvalidFee, "invalid depositor of fee escrow %s%s: %s", fe.ChannelId, fe.ClientId, err)
+	}
+	if err := fe.Amount.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidFee, "fee escrow %s%s of %s: %s", fe.ChannelId, fe.ClientId, fe.Depositor, err)
+	}
+	if fe.Amount.IsZero() {
+		return errors.Wrapf(ErrInvalidFee, "fee escrow %s%s of %s must be positive", fe.ChannelId, fe.ClientId, fe.Depositor)
+	}
+	return nil
+}
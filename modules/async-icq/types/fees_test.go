@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+	"github.com/cosmos/ibc-apps/modules/memo"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *TypesTestSuite) TestParseFeeMemo() {
+	escrow := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	fee := types.FeeMemo{
+		Escrow: escrow,
+		Payer:  "osmo1payer",
+		MaxFee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)),
+	}
+
+	parsed, found, err := types.ParseFeeMemo(types.NewFeeMemo(fee))
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(fee, parsed)
+
+	parsed, found, err = types.ParseFeeMemo(`{"fee":{"escrow":"` + escrow + `","payer":"osmo1payer","max_fee":[{"denom":"uatom","amount":"10"}]},"other":{}}`)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(fee, parsed)
+
+	suite.Require().Empty(types.NewFeeMemo(types.FeeMemo{Escrow: escrow, Payer: "osmo1payer"}))
+	for _, memo := range []string{"", "not json", `{"other":{}}`} {
+		_, found, err := types.ParseFeeMemo(memo)
+		suite.Require().NoError(err, memo)
+		suite.Require().False(found, memo)
+	}
+
+	for _, memo := range []string{
+		`{"fee":"10uatom"}`,
+		`{"fee":{"escrow":"` + escrow + `","payer":"osmo1payer","max_fee":[{"denom":"uatom","amount":"-10"}]}}`,
+		`{"fee":{"escrow":"` + escrow + `","payer":"osmo1payer"}}`,
+		`{"fee":{"escrow":"` + escrow + `","max_fee":[{"denom":"uatom","amount":"10"}]}}`,
+		`{"fee":{"escrow":"invalid","payer":"osmo1payer","max_fee":[{"denom":"uatom","amount":"10"}]}}`,
+	} {
+		_, found, err := types.ParseFeeMemo(memo)
+		suite.Require().True(found, memo)
+		suite.Require().ErrorIs(err, types.ErrInvalidFee, memo)
+	}
+}
+
+func (suite *TypesTestSuite) TestRegisterMemoSections() {
+	registry := memo.NewRegistry(memo.Config{RejectUnknownKeys: true})
+	suite.Require().NoError(types.RegisterMemoSections(registry))
+	suite.Require().Error(types.RegisterMemoSections(registry))
+
+	_, err := registry.Parse(types.NewFeeMemo(types.FeeMemo{
+		Escrow: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		Payer:  "osmo1payer",
+		MaxFee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)),
+	}))
+	suite.Require().NoError(err)
+	_, err = registry.Parse(`{"fee":{"max_fee":[{"denom":"uatom","amount":"-10"}]}}`)
+	suite.Require().ErrorIs(err, types.ErrInvalidFee)
+	_, err = registry.Parse(`{"fee":{},"other":{}}`)
+	suite.Require().Error(err)
+}
+
+func (suite *TypesTestSuite) TestRequiredQueryFee() {
+	queryFees := []types.QueryFee{
+		{Path: "/cosmos.bank.v1beta1.Query/Balance", Fee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 5))},
+		{Path: "/cosmos.bank.v1beta1.Query/*", Fee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))},
+	}
+
+	fee := types.RequiredQueryFee(queryFees, []string{
+		"/cosmos.bank.v1beta1.Query/Balance",
+		"/cosmos.bank.v1beta1.Query/AllBalances",
+		"/cosmos.staking.v1beta1.Query/Validators",
+	})
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uatom", 6)), fee)
+	suite.Require().True(types.RequiredQueryFee(nil, []string{"/cosmos.bank.v1beta1.Query/Balance"}).IsZero())
+}
+
+func (suite *TypesTestSuite) TestFeeEscrowValidate() {
+	depositor := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))
+
+	testCases := []struct {
+		name      string
+		feeEscrow types.FeeEscrow
+		expPass   bool
+	}{
+		{"channel", types.FeeEscrow{ChannelId: "channel-0", Depositor: depositor, Amount: amount}, true},
+		{"client with payer", types.FeeEscrow{ClientId: "07-tendermint-0", Depositor: depositor, Payer: "osmo1payer", Amount: amount}, true},
+		{"channel and client", types.FeeEscrow{ChannelId: "channel-0", ClientId: "07-tendermint-0", Depositor: depositor, Amount: amount}, false},
+		{"no channel or client", types.FeeEscrow{Depositor: depositor, Amount: amount}, false},
+		{"invalid channel", types.FeeEscrow{ChannelId: "invalid", Depositor: depositor, Amount: amount}, false},
+		{"invalid depositor", types.FeeEscrow{ChannelId: "channel-0", Depositor: "invalid", Amount: amount}, false},
+		{"zero amount", types.FeeEscrow{ChannelId: "channel-0", Depositor: depositor}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.feeEscrow.Validate()
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
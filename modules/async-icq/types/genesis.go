@@ -1,14 +1,17 @@
 package types
 
 import (
-	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 )
 
 // DefaultGenesis creates and returns the default interchain query GenesisState
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		HostPort: PortID,
-		Params:   DefaultParams(),
+		HostPort:       PortID,
+		Params:         DefaultParams(),
+		ControllerPort: ControllerPortID,
 	}
 }
 
@@ -25,5 +28,83 @@ func (gs GenesisState) Validate() error {
 	if err := host.PortIdentifierValidator(gs.HostPort); err != nil {
 		return err
 	}
+	if gs.ControllerPort != "" {
+		if err := host.PortIdentifierValidator(gs.ControllerPort); err != nil {
+			return err
+		}
+		if gs.ControllerPort == gs.HostPort {
+			return errors.Wrap(ErrInvalidControllerPort, "controller port must differ from the host port")
+		}
+	}
+	overrides := make(map[string]bool, len(gs.AllowlistOverrides))
+	for _, override := range gs.AllowlistOverrides {
+		if err := override.Validate(); err != nil {
+			return err
+		}
+		key := string(AllowlistOverrideKey(override.ChannelId, override.ConnectionId, override.ClientId))
+		if overrides[key] {
+			return errors.Wrapf(ErrInvalidAllowlist, "duplicate allowlist override of channel %q connection %q client %q", override.ChannelId, override.ConnectionId, override.ClientId)
+		}
+		overrides[key] = true
+	}
+	activeChannels := make(map[string]bool, len(gs.ActiveChannels))
+	for _, activeChannel := range gs.ActiveChannels {
+		if err := host.ConnectionIdentifierValidator(activeChannel.ConnectionId); err != nil {
+			return err
+		}
+		if err := host.ChannelIdentifierValidator(activeChannel.ChannelId); err != nil {
+			return err
+		}
+		if activeChannels[activeChannel.ConnectionId] {
+			return errors.Wrapf(ErrActiveChannelAlreadySet, "duplicate active channel of connection %s", activeChannel.ConnectionId)
+		}
+		activeChannels[activeChannel.ConnectionId] = true
+	}
+
+	pendingQueries := make(map[string]bool, len(gs.PendingQueries))
+	for _, pendingQuery := range gs.PendingQueries {
+		if err := host.ChannelIdentifierValidator(pendingQuery.ChannelId); err != nil {
+			return err
+		}
+		if pendingQuery.Sequence == 0 || pendingQuery.CallbackId == "" {
+			return errors.Wrapf(ErrInvalidQuery, "pending query %s/%d must have a sequence and a callback id", pendingQuery.ChannelId, pendingQuery.Sequence)
+		}
+		key := string(PendingQueryKey(pendingQuery.ChannelId, pendingQuery.Sequence))
+		if pendingQueries[key] {
+			return errors.Wrapf(ErrInvalidQuery, "duplicate pending query %s/%d", pendingQuery.ChannelId, pendingQuery.Sequence)
+		}
+		pendingQueries[key] = true
+	}
+
+	results := make(map[string]bool, len(gs.QueryResults))
+	for _, result := range gs.QueryResults {
+		if err := host.ChannelIdentifierValidator(result.ChannelId); err != nil {
+			return err
+		}
+		if result.Sequence == 0 || result.Sender == "" {
+			return errors.Wrapf(ErrInvalidQuery, "query result %s/%d must have a sequence and a sender", result.ChannelId, result.Sequence)
+		}
+		key := string(QueryResultKey(result.ChannelId, result.Sequence))
+		if results[key] {
+			return errors.Wrapf(ErrInvalidQuery, "duplicate query result %s/%d", result.ChannelId, result.Sequence)
+		}
+		results[key] = true
+		// the pending results are completed by the handler of their pending query
+		if result.Status == QueryStatusPending && !pendingQueries[string(PendingQueryKey(result.ChannelId, result.Sequence))] {
+			return errors.Wrapf(ErrInvalidQuery, "pending query result %s/%d has no pending query", result.ChannelId, result.Sequence)
+		}
+	}
+
+	feeEscrows := make(map[string]bool, len(gs.FeeEscrows))
+	for _, feeEscrow := range gs.FeeEscrows {
+		if err := feeEscrow.Validate(); err != nil {
+			return err
+		}
+		key := string(FeeEscrowKey(feeEscrow.ChannelId, feeEscrow.ClientId, feeEscrow.Depositor))
+		if feeEscrows[key] {
+			return errors.Wrapf(ErrInvalidFee, "duplicate fee escrow %s%s of %s", feeEscrow.ChannelId, feeEscrow.ClientId, feeEscrow.Depositor)
+		}
+		feeEscrows[key] = true
+	}
 	return gs.Params.Validate()
 }
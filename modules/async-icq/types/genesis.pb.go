@@ -27,6 +27,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	HostPort string `protobuf:"bytes,1,opt,name=host_port,json=hostPort,proto3" json:"host_port,omitempty"`
 	Params   Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	// controller_port is the port the controller sends the queries of the Go modules from. It is not bound if empty.
+	ControllerPort string `protobuf:"bytes,5,opt,name=controller_port,json=controllerPort,proto3" json:"controller_port,omitempty"`
+	// allowlist_overrides are the allowlists of the host channels and connections.
+	AllowlistOverrides []AllowlistOverride `protobuf:"bytes,6,rep,name=allowlist_overrides,json=allowlistOverrides,proto3" json:"allowlist_overrides"`
+	// active_channels are the controller channels of the connections.
+	ActiveChannels []ActiveChannel `protobuf:"bytes,8,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels"`
+	// pending_queries are the queries sent by the controller awaiting their acknowledgement or timeout.
+	PendingQueries []PendingQuery `protobuf:"bytes,9,rep,name=pending_queries,json=pendingQueries,proto3" json:"pending_queries"`
+	// query_results are the results of the queries sent with MsgSendQuery.
+	QueryResults []QueryResult `protobuf:"bytes,10,rep,name=query_results,json=queryResults,proto3" json:"query_results"`
+	// fee_escrows are the balances escrowed by the host to pay the fees of the queries of its channels and clients.
+	FeeEscrows []FeeEscrow `protobuf:"bytes,11,rep,name=fee_escrows,json=feeEscrows,proto3" json:"fee_escrows"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,29 +88,218 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetControllerPort() string {
+	if m != nil {
+		return m.ControllerPort
+	}
+	return ""
+}
+
+func (m *GenesisState) GetAllowlistOverrides() []AllowlistOverride {
+	if m != nil {
+		return m.AllowlistOverrides
+	}
+	return nil
+}
+
+func (m *GenesisState) GetActiveChannels() []ActiveChannel {
+	if m != nil {
+		return m.ActiveChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingQueries() []PendingQuery {
+	if m != nil {
+		return m.PendingQueries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueryResults() []QueryResult {
+	if m != nil {
+		return m.QueryResults
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeEscrows() []FeeEscrow {
+	if m != nil {
+		return m.FeeEscrows
+	}
+	return nil
+}
+
+// ActiveChannel is the controller channel the queries of a connection are sent on.
+type ActiveChannel struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	ChannelId    string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *ActiveChannel) Reset()         { *m = ActiveChannel{} }
+func (m *ActiveChannel) String() string { return proto.CompactTextString(m) }
+func (*ActiveChannel) ProtoMessage()    {}
+func (*ActiveChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e676a717932d9bd5, []int{1}
+}
+func (m *ActiveChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActiveChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActiveChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActiveChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveChannel.Merge(m, src)
+}
+func (m *ActiveChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActiveChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveChannel proto.InternalMessageInfo
+
+func (m *ActiveChannel) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ActiveChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// PendingQuery is a query sent by the controller awaiting its acknowledgement or timeout.
+type PendingQuery struct {
+	// channel_id is the controller channel the query was sent on.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence of the query packet.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// callback_id is the ID of the handler of the result of the query.
+	CallbackId string `protobuf:"bytes,3,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	// min_proof_height is the revision height of the latest height of the client of the channel when the query was
+	// sent. The proven responses to the requests sent without a height must be served at or above it.
+	MinProofHeight uint64 `protobuf:"varint,4,opt,name=min_proof_height,json=minProofHeight,proto3" json:"min_proof_height,omitempty"`
+}
+
+func (m *PendingQuery) Reset()         { *m = PendingQuery{} }
+func (m *PendingQuery) String() string { return proto.CompactTextString(m) }
+func (*PendingQuery) ProtoMessage()    {}
+func (*PendingQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e676a717932d9bd5, []int{2}
+}
+func (m *PendingQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingQuery.Merge(m, src)
+}
+func (m *PendingQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingQuery proto.InternalMessageInfo
+
+func (m *PendingQuery) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PendingQuery) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingQuery) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *PendingQuery) GetMinProofHeight() uint64 {
+	if m != nil {
+		return m.MinProofHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "icq.v1.GenesisState")
+	proto.RegisterType((*ActiveChannel)(nil), "icq.v1.ActiveChannel")
+	proto.RegisterType((*PendingQuery)(nil), "icq.v1.PendingQuery")
 }
 
 func init() { proto.RegisterFile("icq/v1/genesis.proto", fileDescriptor_e676a717932d9bd5) }
 
 var fileDescriptor_e676a717932d9bd5 = []byte{
-	// 233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xc9, 0x4c, 0x2e, 0xd4,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x62, 0xcb, 0x4c, 0x2e, 0xd4, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x0b,
-	0xe9, 0x83, 0x58, 0x10, 0x59, 0x29, 0x01, 0xa8, 0x1e, 0x90, 0x22, 0xb0, 0x88, 0x52, 0x24, 0x17,
-	0x8f, 0x3b, 0xc4, 0x80, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x69, 0x2e, 0xce, 0x8c, 0xfc, 0xe2,
-	0x92, 0xf8, 0x82, 0xfc, 0xa2, 0x12, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x0e, 0x90, 0x40,
-	0x40, 0x7e, 0x51, 0x89, 0x90, 0x0e, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0x8b,
-	0x02, 0xa3, 0x06, 0xb7, 0x11, 0x9f, 0x1e, 0xc4, 0x36, 0xbd, 0x00, 0xb0, 0xa8, 0x13, 0xcb, 0x89,
-	0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x35, 0x4e, 0xfe, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
-	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c,
-	0xc7, 0x10, 0x65, 0x9a, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x9c,
-	0x5f, 0x9c, 0x9b, 0x5f, 0xac, 0x9f, 0x99, 0x94, 0xac, 0x9b, 0x58, 0x50, 0x50, 0xac, 0x9f, 0x9b,
-	0x9f, 0x52, 0x9a, 0x93, 0x5a, 0xac, 0x9f, 0x58, 0x5c, 0x99, 0x97, 0xac, 0x0b, 0x76, 0xaf, 0x85,
-	0x7e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xc9, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xc6, 0x5f, 0xa7, 0xdb, 0xfa, 0x00, 0x00, 0x00,
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0xcd, 0x6e, 0xda, 0x4c,
+	0x18, 0x85, 0xf1, 0x17, 0x3e, 0x14, 0x06, 0x42, 0xd2, 0x49, 0x2a, 0xb9, 0xa9, 0xea, 0x44, 0x74,
+	0x51, 0x16, 0x0d, 0x6e, 0x52, 0xa9, 0xea, 0xaa, 0x52, 0x93, 0xfe, 0xb1, 0x2a, 0x25, 0xbb, 0x6e,
+	0xac, 0x61, 0xfc, 0x62, 0x46, 0xb5, 0x67, 0xcc, 0xbc, 0x63, 0x22, 0xee, 0xa2, 0x8b, 0x5e, 0x54,
+	0x96, 0x59, 0xb6, 0x9b, 0xaa, 0x82, 0x1b, 0xa9, 0x3c, 0xb6, 0x21, 0x65, 0x07, 0xcf, 0x39, 0xe7,
+	0x19, 0x0d, 0xd8, 0xe4, 0x48, 0xf0, 0x99, 0x3f, 0x3f, 0xf7, 0x23, 0x90, 0x80, 0x02, 0xfb, 0xa9,
+	0x56, 0x46, 0xd1, 0x86, 0xe0, 0xb3, 0xfe, 0xfc, 0xfc, 0xf8, 0x28, 0x52, 0x91, 0xb2, 0xc8, 0xcf,
+	0x3f, 0x15, 0xe9, 0xf1, 0x41, 0xb9, 0xc9, 0x4b, 0x96, 0x74, 0x7f, 0xed, 0x90, 0xf6, 0xc7, 0xc2,
+	0x70, 0x6d, 0x98, 0x01, 0xfa, 0x98, 0x34, 0xa7, 0x0a, 0x4d, 0x90, 0x2a, 0x6d, 0x5c, 0xe7, 0xd4,
+	0xe9, 0x35, 0x47, 0xbb, 0x39, 0x18, 0x2a, 0x6d, 0xe8, 0x73, 0xd2, 0x48, 0x99, 0x66, 0x09, 0xba,
+	0xf5, 0x53, 0xa7, 0xd7, 0xba, 0xe8, 0xf4, 0x8b, 0xe3, 0xfa, 0x43, 0x4b, 0x2f, 0xeb, 0xb7, 0xbf,
+	0x4f, 0x6a, 0xa3, 0xb2, 0x43, 0x9f, 0x91, 0x7d, 0xae, 0xa4, 0xd1, 0x2a, 0x8e, 0x41, 0x17, 0xc2,
+	0xff, 0xad, 0xb0, 0xb3, 0xc1, 0x56, 0x3b, 0x24, 0x87, 0x2c, 0x8e, 0xd5, 0x4d, 0x2c, 0xd0, 0x04,
+	0x6a, 0x0e, 0x5a, 0x8b, 0x10, 0xd0, 0x6d, 0x9c, 0xee, 0xf4, 0x5a, 0x17, 0x8f, 0xaa, 0x33, 0xde,
+	0x56, 0x95, 0xcf, 0x65, 0xa3, 0x3c, 0x8e, 0xb2, 0xed, 0x00, 0xe9, 0x3b, 0xb2, 0xcf, 0xb8, 0x11,
+	0x73, 0x08, 0xf8, 0x94, 0x49, 0x09, 0x31, 0xba, 0xbb, 0xd6, 0xf6, 0x70, 0x6d, 0xb3, 0xf1, 0x55,
+	0x91, 0x96, 0xa6, 0x0e, 0xbb, 0x0f, 0x91, 0x5e, 0x91, 0xfd, 0x14, 0x64, 0x28, 0x64, 0x14, 0xcc,
+	0x32, 0xd0, 0x02, 0xd0, 0x6d, 0x5a, 0xcb, 0xd1, 0xfa, 0xde, 0x45, 0xfc, 0x25, 0x03, 0xbd, 0xa8,
+	0x24, 0xe9, 0x86, 0x09, 0x40, 0xfa, 0x86, 0xec, 0xe5, 0xe3, 0x45, 0xa0, 0x01, 0xb3, 0xd8, 0xa0,
+	0x4b, 0xac, 0xe2, 0xb0, 0x52, 0xd8, 0xed, 0xc8, 0x66, 0xa5, 0xa1, 0x3d, 0xdb, 0x20, 0xa4, 0xaf,
+	0x49, 0x6b, 0x02, 0x10, 0x00, 0x72, 0xad, 0x6e, 0xd0, 0x6d, 0xd9, 0xf5, 0x83, 0x6a, 0xfd, 0x01,
+	0xe0, 0xbd, 0x4d, 0xca, 0x2d, 0x99, 0x54, 0x00, 0xbb, 0xd7, 0x64, 0xef, 0x9f, 0x5b, 0xd2, 0xa7,
+	0x64, 0x8f, 0x2b, 0x29, 0x81, 0x1b, 0xa1, 0x64, 0x20, 0xc2, 0xf2, 0xff, 0x6d, 0x6f, 0xe0, 0x20,
+	0xa4, 0x4f, 0x08, 0x29, 0x7f, 0xb3, 0xbc, 0xf1, 0x9f, 0x6d, 0x34, 0x4b, 0x32, 0x08, 0xbb, 0x3f,
+	0x1c, 0xd2, 0xbe, 0x7f, 0xeb, 0xad, 0xbe, 0xb3, 0xd5, 0xa7, 0xc7, 0x64, 0x17, 0x61, 0x96, 0x81,
+	0xe4, 0x60, 0x65, 0xf5, 0xd1, 0xfa, 0x3b, 0x3d, 0x21, 0x2d, 0xce, 0xe2, 0x78, 0xcc, 0xf8, 0xb7,
+	0x7c, 0xbb, 0x63, 0xb7, 0xa4, 0x42, 0x83, 0x90, 0xf6, 0xc8, 0x41, 0x22, 0x64, 0x90, 0x6a, 0xa5,
+	0x26, 0xc1, 0x14, 0x44, 0x34, 0x35, 0xf6, 0xc9, 0xab, 0x8f, 0x3a, 0x89, 0x90, 0xc3, 0x1c, 0x7f,
+	0xb2, 0xf4, 0x72, 0x78, 0xbb, 0xf4, 0x9c, 0xbb, 0xa5, 0xe7, 0xfc, 0x59, 0x7a, 0xce, 0xf7, 0x95,
+	0x57, 0xbb, 0x5b, 0x79, 0xb5, 0x9f, 0x2b, 0xaf, 0xf6, 0xf5, 0x55, 0x24, 0xcc, 0x34, 0x1b, 0xf7,
+	0xb9, 0x4a, 0x7c, 0xae, 0x30, 0x51, 0xe8, 0x8b, 0x31, 0x3f, 0x63, 0x69, 0x8a, 0x7e, 0xa2, 0xc2,
+	0x2c, 0x06, 0xf4, 0x19, 0x2e, 0x24, 0x3f, 0x2b, 0x5e, 0x8e, 0x17, 0xbe, 0x59, 0xa4, 0x80, 0xe3,
+	0x86, 0x7d, 0x41, 0x5e, 0xfe, 0x1d, 0x00, 0x55, 0xab, 0xfa, 0x8d, 0x68, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -121,6 +322,83 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeEscrows) > 0 {
+		for iNdEx := len(m.FeeEscrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.QueryResults) > 0 {
+		for iNdEx := len(m.QueryResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueryResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PendingQueries) > 0 {
+		for iNdEx := len(m.PendingQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ActiveChannels) > 0 {
+		for iNdEx := len(m.ActiveChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AllowlistOverrides) > 0 {
+		for iNdEx := len(m.AllowlistOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowlistOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ControllerPort) > 0 {
+		i -= len(m.ControllerPort)
+		copy(dAtA[i:], m.ControllerPort)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ControllerPort)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -141,6 +419,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ActiveChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActiveChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinProofHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinProofHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -164,6 +526,80 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.ControllerPort)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.AllowlistOverrides) > 0 {
+		for _, e := range m.AllowlistOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ActiveChannels) > 0 {
+		for _, e := range m.ActiveChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingQueries) > 0 {
+		for _, e := range m.PendingQueries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueryResults) > 0 {
+		for _, e := range m.QueryResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeEscrows) > 0 {
+		for _, e := range m.FeeEscrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ActiveChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MinProofHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.MinProofHeight))
+	}
 	return n
 }
 
@@ -267,6 +703,474 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowlistOverrides = append(m.AllowlistOverrides, AllowlistOverride{})
+			if err := m.AllowlistOverrides[len(m.AllowlistOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveChannels = append(m.ActiveChannels, ActiveChannel{})
+			if err := m.ActiveChannels[len(m.ActiveChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingQueries = append(m.PendingQueries, PendingQuery{})
+			if err := m.PendingQueries[len(m.PendingQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryResults = append(m.QueryResults, QueryResult{})
+			if err := m.QueryResults[len(m.QueryResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEscrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeEscrows = append(m.FeeEscrows, FeeEscrow{})
+			if err := m.FeeEscrows[len(m.FeeEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProofHeight", wireType)
+			}
+			m.MinProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
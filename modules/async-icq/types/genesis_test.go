@@ -3,10 +3,12 @@ package types_test
 import (
 	"testing"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 	"github.com/stretchr/testify/suite"
 
-	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 )
 
 type TypesTestSuite struct {
@@ -56,6 +58,27 @@ func (suite *TypesTestSuite) TestValidateGenesisState() {
 			},
 			false,
 		},
+		{
+			"success - controller not bound",
+			func() {
+				genesisState.ControllerPort = ""
+			},
+			true,
+		},
+		{
+			"failed to validate - invalid controller port",
+			func() {
+				genesisState.ControllerPort = "p"
+			},
+			false,
+		},
+		{
+			"failed to validate - controller port is the host port",
+			func() {
+				genesisState.ControllerPort = types.PortID
+			},
+			false,
+		},
 		{
 			"failed to validate - invalid empty query path",
 			func() {
@@ -63,6 +86,155 @@ func (suite *TypesTestSuite) TestValidateGenesisState() {
 			},
 			false,
 		},
+		{
+			"failed to validate - malformed query path pattern",
+			func() {
+				genesisState.Params = types.NewParams(true, []string{"/cosmos.bank.v1beta1.Query/["})
+			},
+			false,
+		},
+		{
+			"success - allowlist overrides",
+			func() {
+				genesisState.AllowlistOverrides = []types.AllowlistOverride{
+					types.NewAllowlistOverride("channel-0", "", []string{"/cosmos.bank.v1beta1.Query/*"}),
+					types.NewAllowlistOverride("", "connection-0", nil),
+					types.NewClientAllowlistOverride("07-tendermint-0", []string{"/cosmos.bank.v1beta1.Query/*"}),
+				}
+			},
+			true,
+		},
+		{
+			"failed to validate - allowlist override of a channel and a connection",
+			func() {
+				genesisState.AllowlistOverrides = []types.AllowlistOverride{
+					types.NewAllowlistOverride("channel-0", "connection-0", nil),
+				}
+			},
+			false,
+		},
+		{
+			"failed to validate - allowlist override of a channel and a client",
+			func() {
+				override := types.NewClientAllowlistOverride("07-tendermint-0", nil)
+				override.ChannelId = "channel-0"
+				genesisState.AllowlistOverrides = []types.AllowlistOverride{override}
+			},
+			false,
+		},
+		{
+			"failed to validate - allowlist override of an invalid client",
+			func() {
+				genesisState.AllowlistOverrides = []types.AllowlistOverride{
+					types.NewClientAllowlistOverride("07", nil),
+				}
+			},
+			false,
+		},
+		{
+			"failed to validate - duplicate allowlist override",
+			func() {
+				genesisState.AllowlistOverrides = []types.AllowlistOverride{
+					types.NewAllowlistOverride("channel-0", "", nil),
+					types.NewAllowlistOverride("channel-0", "", []string{"/cosmos.bank.v1beta1.Query/*"}),
+				}
+			},
+			false,
+		},
+		{
+			"success - query fees",
+			func() {
+				genesisState.Params.QueryFees = []types.QueryFee{
+					{Path: "/cosmos.bank.v1beta1.Query/*", Fee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))},
+				}
+				genesisState.Params.FeeRecipient = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			true,
+		},
+		{
+			"failed to validate - query fees without fee recipient",
+			func() {
+				genesisState.Params.QueryFees = []types.QueryFee{
+					{Path: "/cosmos.bank.v1beta1.Query/*", Fee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))},
+				}
+			},
+			false,
+		},
+		{
+			"failed to validate - zero query fee",
+			func() {
+				genesisState.Params.QueryFees = []types.QueryFee{{Path: "/cosmos.bank.v1beta1.Query/*"}}
+				genesisState.Params.FeeRecipient = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"success - controller state",
+			func() {
+				genesisState.ActiveChannels = []types.ActiveChannel{{ConnectionId: "connection-0", ChannelId: "channel-1"}}
+				genesisState.PendingQueries = []types.PendingQuery{{ChannelId: "channel-1", Sequence: 1, CallbackId: types.ModuleName}}
+				genesisState.QueryResults = []types.QueryResult{
+					{Sender: suite.chainA.SenderAccount.GetAddress().String(), ChannelId: "channel-1", Sequence: 1, Status: types.QueryStatusPending},
+					{Sender: suite.chainA.SenderAccount.GetAddress().String(), ChannelId: "channel-1", Sequence: 2, Status: types.QueryStatusSuccess},
+				}
+			},
+			true,
+		},
+		{
+			"success - fee escrows",
+			func() {
+				genesisState.FeeEscrows = []types.FeeEscrow{
+					{ChannelId: "channel-0", Depositor: suite.chainA.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))},
+					{ClientId: "07-tendermint-0", Depositor: suite.chainA.SenderAccount.GetAddress().String(), Payer: "osmo1payer", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))},
+				}
+			},
+			true,
+		},
+		{
+			"failed to validate - duplicate active channel",
+			func() {
+				genesisState.ActiveChannels = []types.ActiveChannel{
+					{ConnectionId: "connection-0", ChannelId: "channel-1"},
+					{ConnectionId: "connection-0", ChannelId: "channel-2"},
+				}
+			},
+			false,
+		},
+		{
+			"failed to validate - pending query without callback id",
+			func() {
+				genesisState.PendingQueries = []types.PendingQuery{{ChannelId: "channel-1", Sequence: 1}}
+			},
+			false,
+		},
+		{
+			"failed to validate - pending query result without pending query",
+			func() {
+				genesisState.QueryResults = []types.QueryResult{
+					{Sender: suite.chainA.SenderAccount.GetAddress().String(), ChannelId: "channel-1", Sequence: 1, Status: types.QueryStatusPending},
+				}
+			},
+			false,
+		},
+		{
+			"failed to validate - duplicate fee escrow",
+			func() {
+				genesisState.FeeEscrows = []types.FeeEscrow{
+					{ChannelId: "channel-0", Depositor: suite.chainA.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))},
+					{ChannelId: "channel-0", Depositor: suite.chainA.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 20))},
+				}
+			},
+			false,
+		},
+		{
+			"failed to validate - fee escrow without amount",
+			func() {
+				genesisState.FeeEscrows = []types.FeeEscrow{
+					{ChannelId: "channel-0", Depositor: suite.chainA.SenderAccount.GetAddress().String()},
+				}
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
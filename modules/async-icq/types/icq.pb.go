@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	types1 "github.com/cometbft/cometbft/abci/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -23,12 +26,65 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueryStatus is the status of a query sent with MsgSendQuery.
+type QueryStatus int32
+
+const (
+	// QUERY_STATUS_PENDING is the status of a query awaiting its acknowledgement or timeout.
+	QueryStatusPending QueryStatus = 0
+	// QUERY_STATUS_SUCCESS is the status of a query answered by the host.
+	QueryStatusSuccess QueryStatus = 1
+	// QUERY_STATUS_FAILED is the status of a query the host returned an error acknowledgement for.
+	QueryStatusFailed QueryStatus = 2
+	// QUERY_STATUS_TIMEOUT is the status of a query that timed out.
+	QueryStatusTimeout QueryStatus = 3
+)
+
+var QueryStatus_name = map[int32]string{
+	0: "QUERY_STATUS_PENDING",
+	1: "QUERY_STATUS_SUCCESS",
+	2: "QUERY_STATUS_FAILED",
+	3: "QUERY_STATUS_TIMEOUT",
+}
+
+var QueryStatus_value = map[string]int32{
+	"QUERY_STATUS_PENDING": 0,
+	"QUERY_STATUS_SUCCESS": 1,
+	"QUERY_STATUS_FAILED":  2,
+	"QUERY_STATUS_TIMEOUT": 3,
+}
+
+func (x QueryStatus) String() string {
+	return proto.EnumName(QueryStatus_name, int32(x))
+}
+
+func (QueryStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0a9dc71eedc8bea6, []int{0}
+}
+
 // Params defines the set of on-chain interchain query parameters.
 type Params struct {
 	// host_enabled enables or disables the host submodule.
 	HostEnabled bool `protobuf:"varint,2,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty" yaml:"host_enabled"`
-	// allow_queries defines a list of query paths allowed to be queried on a host chain.
+	// allow_queries defines a list of query paths allowed to be queried on a host chain. A path may be a glob
+	// pattern, e.g. /cosmos.bank.v1beta1.Query/* allows all the queries of the bank service.
 	AllowQueries []string `protobuf:"bytes,3,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty" yaml:"allow_queries"`
+	// max_requests_per_packet is the maximum number of query requests in a packet. 0 disables the limit.
+	MaxRequestsPerPacket uint64 `protobuf:"varint,4,opt,name=max_requests_per_packet,json=maxRequestsPerPacket,proto3" json:"max_requests_per_packet,omitempty" yaml:"max_requests_per_packet"`
+	// max_gas_per_packet is the gas limit of the queries of a packet, which run with their own gas meter.
+	// 0 disables the limit.
+	MaxGasPerPacket uint64 `protobuf:"varint,5,opt,name=max_gas_per_packet,json=maxGasPerPacket,proto3" json:"max_gas_per_packet,omitempty" yaml:"max_gas_per_packet"`
+	// max_response_bytes is the maximum size of the responses to the queries of a packet. 0 disables the limit.
+	MaxResponseBytes uint64 `protobuf:"varint,6,opt,name=max_response_bytes,json=maxResponseBytes,proto3" json:"max_response_bytes,omitempty" yaml:"max_response_bytes"`
+	// query_fees are the fees of the query requests, by query path. The fee of a request is the one of the first
+	// entry matching its path, and the requests matching no entry are free.
+	QueryFees []QueryFee `protobuf:"bytes,8,rep,name=query_fees,json=queryFees,proto3" json:"query_fees" yaml:"query_fees"`
+	// fee_recipient is the account the fees charged from the fee escrows are sent to. It must be set when there are
+	// query fees.
+	FeeRecipient string `protobuf:"bytes,9,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty" yaml:"fee_recipient"`
+	// contract_callback_gas_limit is the gas available to a contract to process the result of its query. 0 uses the
+	// default limit.
+	ContractCallbackGasLimit uint64 `protobuf:"varint,10,opt,name=contract_callback_gas_limit,json=contractCallbackGasLimit,proto3" json:"contract_callback_gas_limit,omitempty" yaml:"contract_callback_gas_limit"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -78,30 +134,433 @@ func (m *Params) GetAllowQueries() []string {
 	return nil
 }
 
+func (m *Params) GetMaxRequestsPerPacket() uint64 {
+	if m != nil {
+		return m.MaxRequestsPerPacket
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGasPerPacket() uint64 {
+	if m != nil {
+		return m.MaxGasPerPacket
+	}
+	return 0
+}
+
+func (m *Params) GetMaxResponseBytes() uint64 {
+	if m != nil {
+		return m.MaxResponseBytes
+	}
+	return 0
+}
+
+func (m *Params) GetQueryFees() []QueryFee {
+	if m != nil {
+		return m.QueryFees
+	}
+	return nil
+}
+
+func (m *Params) GetFeeRecipient() string {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetContractCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.ContractCallbackGasLimit
+	}
+	return 0
+}
+
+// QueryFee is the fee of each query request whose path matches a query path or glob pattern.
+type QueryFee struct {
+	// path is the query path or glob pattern.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// fee is the fee of each request matching the path.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *QueryFee) Reset()         { *m = QueryFee{} }
+func (m *QueryFee) String() string { return proto.CompactTextString(m) }
+func (*QueryFee) ProtoMessage()    {}
+func (*QueryFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a9dc71eedc8bea6, []int{1}
+}
+func (m *QueryFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFee.Merge(m, src)
+}
+func (m *QueryFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFee proto.InternalMessageInfo
+
+func (m *QueryFee) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *QueryFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+// FeeEscrow is a balance escrowed by the host from a depositor, to pay the fees of the queries received on a host
+// channel, or on a host client with IBC v2. Exactly one of channel_id and client_id is set.
+type FeeEscrow struct {
+	// channel_id is the host channel the escrow pays the query fees of.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// client_id is the host client the escrow pays the query fees of, for the IBC v2 packets received on it.
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// depositor is the account which funded the escrow, and the only one able to withdraw it.
+	Depositor string `protobuf:"bytes,3,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// payer is the sender on the controller chain whose queries the escrow pays for, as declared by the controller in
+	// the memo of the packet data. The escrow pays for the queries of any sender when it is empty.
+	Payer string `protobuf:"bytes,4,opt,name=payer,proto3" json:"payer,omitempty"`
+	// amount is the escrowed balance.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *FeeEscrow) Reset()         { *m = FeeEscrow{} }
+func (m *FeeEscrow) String() string { return proto.CompactTextString(m) }
+func (*FeeEscrow) ProtoMessage()    {}
+func (*FeeEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a9dc71eedc8bea6, []int{2}
+}
+func (m *FeeEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeEscrow.Merge(m, src)
+}
+func (m *FeeEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeEscrow proto.InternalMessageInfo
+
+func (m *FeeEscrow) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *FeeEscrow) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *FeeEscrow) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *FeeEscrow) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *FeeEscrow) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// AllowlistOverride is an allowlist of query paths replacing the allow_queries of the params for the queries
+// received on a host channel, on the host channels of a connection, or on a client with IBC v2. Exactly one of
+// channel_id, connection_id and client_id is set.
+type AllowlistOverride struct {
+	// channel_id is the host channel the allowlist applies to.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// connection_id is the connection the allowlist applies to, on the host channels without their own allowlist.
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// allow_queries are the query paths or glob patterns allowed.
+	AllowQueries []string `protobuf:"bytes,3,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty"`
+	// client_id is the host client the allowlist applies to, for the IBC v2 packets received on it.
+	ClientId string `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *AllowlistOverride) Reset()         { *m = AllowlistOverride{} }
+func (m *AllowlistOverride) String() string { return proto.CompactTextString(m) }
+func (*AllowlistOverride) ProtoMessage()    {}
+func (*AllowlistOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a9dc71eedc8bea6, []int{3}
+}
+func (m *AllowlistOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowlistOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowlistOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowlistOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowlistOverride.Merge(m, src)
+}
+func (m *AllowlistOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowlistOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowlistOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowlistOverride proto.InternalMessageInfo
+
+func (m *AllowlistOverride) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *AllowlistOverride) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *AllowlistOverride) GetAllowQueries() []string {
+	if m != nil {
+		return m.AllowQueries
+	}
+	return nil
+}
+
+func (m *AllowlistOverride) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryResult is the result of a query sent with MsgSendQuery.
+type QueryResult struct {
+	// sender is the account that sent the query.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// channel_id is the controller channel the query was sent on.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence of the query packet.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// paths are the query paths of the requests, in order.
+	Paths  []string    `protobuf:"bytes,4,rep,name=paths,proto3" json:"paths,omitempty"`
+	Status QueryStatus `protobuf:"varint,5,opt,name=status,proto3,enum=icq.v1.QueryStatus" json:"status,omitempty"`
+	// responses are the responses of the host, once the query succeeded.
+	Responses []types1.ResponseQuery `protobuf:"bytes,6,rep,name=responses,proto3" json:"responses"`
+	// error is the error of the acknowledgement, if the query failed.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *QueryResult) Reset()         { *m = QueryResult{} }
+func (m *QueryResult) String() string { return proto.CompactTextString(m) }
+func (*QueryResult) ProtoMessage()    {}
+func (*QueryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a9dc71eedc8bea6, []int{4}
+}
+func (m *QueryResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResult.Merge(m, src)
+}
+func (m *QueryResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResult proto.InternalMessageInfo
+
+func (m *QueryResult) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *QueryResult) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryResult) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *QueryResult) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+func (m *QueryResult) GetStatus() QueryStatus {
+	if m != nil {
+		return m.Status
+	}
+	return QueryStatusPending
+}
+
+func (m *QueryResult) GetResponses() []types1.ResponseQuery {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *QueryResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("icq.v1.QueryStatus", QueryStatus_name, QueryStatus_value)
 	proto.RegisterType((*Params)(nil), "icq.v1.Params")
+	proto.RegisterType((*QueryFee)(nil), "icq.v1.QueryFee")
+	proto.RegisterType((*FeeEscrow)(nil), "icq.v1.FeeEscrow")
+	proto.RegisterType((*AllowlistOverride)(nil), "icq.v1.AllowlistOverride")
+	proto.RegisterType((*QueryResult)(nil), "icq.v1.QueryResult")
 }
 
 func init() { proto.RegisterFile("icq/v1/icq.proto", fileDescriptor_0a9dc71eedc8bea6) }
 
 var fileDescriptor_0a9dc71eedc8bea6 = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xc8, 0x4c, 0x2e, 0xd4,
-	0x2f, 0x33, 0xd4, 0xcf, 0x4c, 0x2e, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x03, 0x31,
-	0xcb, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x42, 0xfa, 0x20, 0x16, 0x44, 0x56, 0xa9,
-	0x99, 0x91, 0x8b, 0x2d, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0xc8, 0x8a, 0x8b, 0x27, 0x23, 0xbf,
-	0xb8, 0x24, 0x3e, 0x35, 0x2f, 0x31, 0x29, 0x27, 0x35, 0x45, 0x82, 0x49, 0x81, 0x51, 0x83, 0xc3,
-	0x49, 0xfc, 0xd3, 0x3d, 0x79, 0xe1, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0x64, 0x59, 0xa5, 0x20,
-	0x6e, 0x10, 0xd7, 0x15, 0xc2, 0x13, 0xb2, 0xe5, 0xe2, 0x4d, 0xcc, 0xc9, 0xc9, 0x2f, 0x8f, 0x2f,
-	0x2c, 0x4d, 0x2d, 0xca, 0x4c, 0x2d, 0x96, 0x60, 0x56, 0x60, 0xd6, 0xe0, 0x74, 0x92, 0xf8, 0x74,
-	0x4f, 0x5e, 0x04, 0xa2, 0x19, 0x45, 0x5a, 0x29, 0x88, 0x07, 0xcc, 0x0f, 0x84, 0x70, 0x9d, 0xfc,
-	0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
-	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x34, 0x3d, 0xb3, 0x24, 0xa3,
-	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x58, 0x3f, 0x33, 0x29,
-	0x59, 0x37, 0xb1, 0xa0, 0xa0, 0x58, 0x3f, 0x37, 0x3f, 0xa5, 0x34, 0x27, 0xb5, 0x58, 0x3f, 0xb1,
-	0xb8, 0x32, 0x2f, 0x59, 0x17, 0xec, 0x71, 0x0b, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36,
-	0xb0, 0xef, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x32, 0x5a, 0xea, 0x22, 0x0f, 0x01, 0x00,
-	0x00,
+	// 941 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x2d, 0x45, 0xb5, 0xce, 0x4e, 0x2b, 0x9f, 0xdd, 0x84, 0x96, 0x1b, 0x49, 0x60, 0x81,
+	0xc2, 0x68, 0x61, 0xd2, 0x4e, 0x81, 0x0e, 0x01, 0x3a, 0x58, 0x8e, 0x6c, 0x38, 0x4d, 0x13, 0x85,
+	0xb2, 0x87, 0x14, 0x28, 0x88, 0xd3, 0xe9, 0xd9, 0x3e, 0x98, 0xe4, 0x51, 0xbc, 0x93, 0x63, 0x0d,
+	0xdd, 0x8b, 0x4c, 0x5d, 0x3b, 0x64, 0xea, 0xd6, 0xbf, 0x24, 0x63, 0xc6, 0x76, 0x51, 0x03, 0x7b,
+	0xea, 0xaa, 0xbd, 0x40, 0x71, 0x77, 0x54, 0x64, 0xda, 0x69, 0xbb, 0x74, 0x12, 0xdf, 0xaf, 0xef,
+	0xde, 0xfb, 0xee, 0x7d, 0x27, 0x54, 0x65, 0x74, 0xe0, 0x9d, 0x6d, 0x79, 0x8c, 0x0e, 0xdc, 0x24,
+	0xe5, 0x92, 0xe3, 0xb2, 0xfa, 0x3c, 0xdb, 0xaa, 0xad, 0x1c, 0xf3, 0x63, 0xae, 0x5d, 0x9e, 0xfa,
+	0x32, 0xd1, 0xda, 0x9a, 0x84, 0xb8, 0x0f, 0x69, 0xc4, 0x62, 0xe9, 0x91, 0x1e, 0x65, 0x9e, 0x1c,
+	0x25, 0x20, 0xb2, 0x60, 0x9d, 0x72, 0x11, 0x71, 0xe1, 0xf5, 0x88, 0x00, 0xef, 0x6c, 0xab, 0x07,
+	0x92, 0x6c, 0x79, 0x94, 0xb3, 0xd8, 0xc4, 0x9d, 0x3f, 0x4b, 0xa8, 0xdc, 0x21, 0x29, 0x89, 0x04,
+	0x7e, 0x80, 0x16, 0x4f, 0xb8, 0x90, 0x01, 0xc4, 0xa4, 0x17, 0x42, 0xdf, 0x9e, 0x6b, 0x5a, 0xeb,
+	0xf3, 0xad, 0xbb, 0x93, 0x71, 0x63, 0x79, 0x44, 0xa2, 0xf0, 0x81, 0x73, 0x35, 0xea, 0xf8, 0x0b,
+	0xca, 0x6c, 0x1b, 0x0b, 0x7f, 0x8d, 0x6e, 0x93, 0x30, 0xe4, 0x2f, 0x82, 0xc1, 0x10, 0x52, 0x06,
+	0xc2, 0x2e, 0x36, 0x8b, 0xeb, 0x95, 0x96, 0x3d, 0x19, 0x37, 0x56, 0x4c, 0x71, 0x2e, 0xec, 0xf8,
+	0x8b, 0xda, 0x7e, 0x66, 0x4c, 0xfc, 0x1c, 0xdd, 0x8d, 0xc8, 0x79, 0x90, 0xc2, 0x60, 0x08, 0x42,
+	0x8a, 0x20, 0x81, 0x34, 0x48, 0x08, 0x3d, 0x05, 0x69, 0x97, 0x9a, 0xd6, 0x7a, 0xa9, 0xe5, 0x4c,
+	0xc6, 0x8d, 0xba, 0x01, 0xfa, 0x87, 0x44, 0xc7, 0x5f, 0x89, 0xc8, 0xb9, 0x9f, 0x05, 0x3a, 0x90,
+	0x76, 0xb4, 0x1b, 0x3f, 0x42, 0x58, 0x55, 0x1c, 0x93, 0x1c, 0xea, 0x2d, 0x8d, 0x7a, 0x6f, 0x32,
+	0x6e, 0xac, 0xce, 0x50, 0xf3, 0x39, 0x8e, 0xff, 0x51, 0x44, 0xce, 0xf7, 0xc8, 0x15, 0xac, 0x6f,
+	0x0c, 0x56, 0x0a, 0x22, 0xe1, 0xb1, 0x80, 0xa0, 0x37, 0x92, 0x20, 0xec, 0xf2, 0xfb, 0xb0, 0xf2,
+	0x39, 0x8e, 0x5f, 0xd5, 0xcd, 0x19, 0x5f, 0x4b, 0xb9, 0xf0, 0x23, 0x84, 0x14, 0x1b, 0xa3, 0xe0,
+	0x08, 0x40, 0xd8, 0xf3, 0xcd, 0xe2, 0xfa, 0xc2, 0xfd, 0xaa, 0x6b, 0x6e, 0xda, 0x55, 0xc4, 0x8c,
+	0x76, 0x01, 0x5a, 0xab, 0xaf, 0xc7, 0x8d, 0xc2, 0x64, 0xdc, 0x58, 0x32, 0xd0, 0xb3, 0x0a, 0xc7,
+	0xaf, 0x0c, 0xb2, 0x24, 0xa1, 0xe8, 0x3f, 0x02, 0x08, 0x52, 0xa0, 0x2c, 0x61, 0x10, 0x4b, 0xbb,
+	0xd2, 0xb4, 0xf2, 0xf4, 0xe7, 0xc2, 0x8e, 0xbf, 0x78, 0x04, 0xe0, 0x4f, 0x4d, 0x0c, 0x68, 0x8d,
+	0xf2, 0x58, 0xa6, 0x84, 0xca, 0x80, 0x92, 0x30, 0xec, 0x11, 0x7a, 0xaa, 0xd9, 0x08, 0x59, 0xc4,
+	0xa4, 0x8d, 0xf4, 0x80, 0x9f, 0x4d, 0xc6, 0x0d, 0xc7, 0x80, 0xfd, 0x4b, 0xb2, 0xe3, 0xdb, 0xd3,
+	0xe8, 0x4e, 0x16, 0xdc, 0x23, 0xe2, 0xb1, 0x0e, 0xfd, 0x80, 0xe6, 0xa7, 0x73, 0x61, 0x8c, 0x4a,
+	0x09, 0x91, 0x27, 0xb6, 0xa5, 0x1a, 0xf5, 0xf5, 0x37, 0xfe, 0x1e, 0x15, 0x8f, 0x00, 0xec, 0x39,
+	0x4d, 0xc5, 0xaa, 0x6b, 0x36, 0xd7, 0x55, 0x9b, 0xeb, 0x66, 0x9b, 0xeb, 0xee, 0x70, 0x16, 0xb7,
+	0x36, 0x15, 0x27, 0xbf, 0xfe, 0xd1, 0x58, 0x3f, 0x66, 0xf2, 0x64, 0xd8, 0x73, 0x29, 0x8f, 0xbc,
+	0x6c, 0xcd, 0xcd, 0xcf, 0x86, 0xe8, 0x9f, 0x66, 0x2a, 0x50, 0x05, 0xc2, 0x57, 0xb8, 0xce, 0x5b,
+	0x0b, 0x55, 0x76, 0x01, 0xda, 0x82, 0xa6, 0xfc, 0x05, 0xbe, 0x87, 0x10, 0x3d, 0x21, 0x71, 0x0c,
+	0x61, 0xc0, 0xfa, 0x59, 0x1b, 0x95, 0xcc, 0xb3, 0xdf, 0xc7, 0x6b, 0xa8, 0x42, 0x43, 0x45, 0x4e,
+	0xc0, 0x8c, 0x12, 0x2a, 0xfe, 0xbc, 0x71, 0xec, 0xf7, 0xf1, 0x27, 0xa8, 0xd2, 0x87, 0x84, 0x0b,
+	0x26, 0x79, 0x6a, 0x17, 0x4d, 0xe9, 0x3b, 0x07, 0x5e, 0x41, 0xb7, 0x12, 0x32, 0x82, 0x54, 0xaf,
+	0x6e, 0xc5, 0x37, 0x06, 0xa6, 0xa8, 0x4c, 0x22, 0x3e, 0x8c, 0xd5, 0xee, 0xfd, 0xef, 0xf3, 0x65,
+	0xd0, 0xce, 0xcf, 0x16, 0x5a, 0xda, 0x56, 0xc2, 0x0a, 0x99, 0x90, 0x4f, 0xcf, 0x20, 0x4d, 0x59,
+	0x1f, 0xfe, 0x6b, 0xd4, 0x4f, 0xd1, 0x6d, 0xca, 0xe3, 0x18, 0xa8, 0x64, 0x3c, 0x9e, 0x8d, 0xbb,
+	0x38, 0x73, 0x9a, 0xa4, 0xf7, 0x08, 0xfc, 0x9a, 0x8c, 0x73, 0xa4, 0x95, 0xf2, 0xa4, 0x39, 0x7f,
+	0x59, 0x68, 0x41, 0x5f, 0xbf, 0x0f, 0x62, 0x18, 0x4a, 0x7c, 0x07, 0x95, 0x85, 0x7e, 0xb8, 0xb2,
+	0x8e, 0x32, 0xeb, 0x5a, 0xb7, 0x73, 0xd7, 0xbb, 0xad, 0xa1, 0x79, 0xa1, 0x44, 0x1e, 0x53, 0xd0,
+	0xd4, 0x97, 0xfc, 0x77, 0xb6, 0x61, 0x5e, 0x9e, 0x08, 0xbb, 0xa4, 0x9b, 0x33, 0x06, 0xfe, 0x02,
+	0x95, 0x85, 0x24, 0x72, 0x28, 0xb4, 0xea, 0x3f, 0xbc, 0xbf, 0x9c, 0x13, 0x59, 0x57, 0x87, 0xfc,
+	0x2c, 0x05, 0xb7, 0x50, 0x65, 0x2a, 0x5d, 0xa5, 0x6c, 0x75, 0x53, 0x75, 0x77, 0xf6, 0xc0, 0xba,
+	0xea, 0x81, 0x75, 0xa7, 0x42, 0xd6, 0x00, 0xad, 0x92, 0xba, 0x2e, 0x7f, 0x56, 0xa6, 0xda, 0x80,
+	0x34, 0xe5, 0xa9, 0xfd, 0x81, 0x59, 0x00, 0x6d, 0x7c, 0xfe, 0xfb, 0x74, 0x7e, 0x73, 0x22, 0xde,
+	0x44, 0x2b, 0xcf, 0x0e, 0xdb, 0xfe, 0xf3, 0xa0, 0x7b, 0xb0, 0x7d, 0x70, 0xd8, 0x0d, 0x3a, 0xed,
+	0x27, 0x0f, 0xf7, 0x9f, 0xec, 0x55, 0x0b, 0xb5, 0x3b, 0x2f, 0x5f, 0x35, 0xf1, 0x95, 0xd4, 0x0e,
+	0xc4, 0x7d, 0x16, 0x1f, 0xdf, 0xa8, 0xe8, 0x1e, 0xee, 0xec, 0xb4, 0xbb, 0xdd, 0xaa, 0x75, 0xa3,
+	0xa2, 0x3b, 0xa4, 0x14, 0x84, 0xc0, 0x2e, 0x5a, 0xce, 0x55, 0xec, 0x6e, 0xef, 0x3f, 0x6e, 0x3f,
+	0xac, 0xce, 0xd5, 0x3e, 0x7e, 0xf9, 0xaa, 0xb9, 0x74, 0xa5, 0x60, 0x97, 0x30, 0xf5, 0x8c, 0x5f,
+	0x3f, 0xe1, 0x60, 0xff, 0xdb, 0xf6, 0xd3, 0xc3, 0x83, 0x6a, 0xf1, 0xc6, 0x09, 0x07, 0x2c, 0x02,
+	0x3e, 0x94, 0xb5, 0xd2, 0x8f, 0xbf, 0xd4, 0x0b, 0xad, 0xce, 0xeb, 0x8b, 0xba, 0xf5, 0xe6, 0xa2,
+	0x6e, 0xbd, 0xbd, 0xa8, 0x5b, 0x3f, 0x5d, 0xd6, 0x0b, 0x6f, 0x2e, 0xeb, 0x85, 0xdf, 0x2e, 0xeb,
+	0x85, 0xef, 0xbe, 0xba, 0xb9, 0xc3, 0xac, 0x47, 0x37, 0x48, 0x92, 0x08, 0x2f, 0xe2, 0xfd, 0x61,
+	0x08, 0xc2, 0x23, 0x62, 0x14, 0xd3, 0x0d, 0xf3, 0xaf, 0xb7, 0x69, 0xf6, 0xba, 0x57, 0xd6, 0x7f,
+	0x4f, 0x5f, 0xfe, 0x3d, 0x00, 0x75, 0xbf, 0xbb, 0x39, 0x0d, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -124,6 +583,47 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContractCallbackGasLimit != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.ContractCallbackGasLimit))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintIcq(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.QueryFees) > 0 {
+		for iNdEx := len(m.QueryFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueryFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIcq(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MaxResponseBytes != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.MaxResponseBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxGasPerPacket != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.MaxGasPerPacket))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxRequestsPerPacket != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.MaxRequestsPerPacket))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.AllowQueries) > 0 {
 		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowQueries[iNdEx])
@@ -146,37 +646,414 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintIcq(dAtA []byte, offset int, v uint64) int {
-	offset -= sovIcq(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HostEnabled {
-		n += 2
-	}
-	if len(m.AllowQueries) > 0 {
-		for _, s := range m.AllowQueries {
-			l = len(s)
-			n += 1 + l + sovIcq(uint64(l))
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIcq(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintIcq(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovIcq(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *FeeEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIcq(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintIcq(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintIcq(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintIcq(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIcq(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowlistOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowlistOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowlistOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintIcq(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AllowQueries) > 0 {
+		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowQueries[iNdEx])
+			copy(dAtA[i:], m.AllowQueries[iNdEx])
+			i = encodeVarintIcq(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintIcq(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIcq(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintIcq(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIcq(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarintIcq(dAtA, i, uint64(len(m.Paths[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIcq(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintIcq(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIcq(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIcq(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HostEnabled {
+		n += 2
+	}
+	if len(m.AllowQueries) > 0 {
+		for _, s := range m.AllowQueries {
+			l = len(s)
+			n += 1 + l + sovIcq(uint64(l))
+		}
+	}
+	if m.MaxRequestsPerPacket != 0 {
+		n += 1 + sovIcq(uint64(m.MaxRequestsPerPacket))
+	}
+	if m.MaxGasPerPacket != 0 {
+		n += 1 + sovIcq(uint64(m.MaxGasPerPacket))
+	}
+	if m.MaxResponseBytes != 0 {
+		n += 1 + sovIcq(uint64(m.MaxResponseBytes))
+	}
+	if len(m.QueryFees) > 0 {
+		for _, e := range m.QueryFees {
+			l = e.Size()
+			n += 1 + l + sovIcq(uint64(l))
+		}
+	}
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovIcq(uint64(l))
+	}
+	if m.ContractCallbackGasLimit != 0 {
+		n += 1 + sovIcq(uint64(m.ContractCallbackGasLimit))
+	}
+	return n
+}
+
+func (m *QueryFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovIcq(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovIcq(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIcq(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovIcq(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovIcq(uint64(l))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovIcq(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovIcq(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowlistOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIcq(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovIcq(uint64(l))
+	}
+	if len(m.AllowQueries) > 0 {
+		for _, s := range m.AllowQueries {
+			l = len(s)
+			n += 1 + l + sovIcq(uint64(l))
+		}
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovIcq(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovIcq(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIcq(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovIcq(uint64(m.Sequence))
+	}
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovIcq(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovIcq(uint64(m.Status))
+	}
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovIcq(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovIcq(uint64(l))
+	}
+	return n
+}
+
+func sovIcq(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozIcq(x uint64) (n int) {
 	return sovIcq(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -262,6 +1139,904 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRequestsPerPacket", wireType)
+			}
+			m.MaxRequestsPerPacket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRequestsPerPacket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerPacket", wireType)
+			}
+			m.MaxGasPerPacket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerPacket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResponseBytes", wireType)
+			}
+			m.MaxResponseBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxResponseBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryFees = append(m.QueryFees, QueryFee{})
+			if err := m.QueryFees[len(m.QueryFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallbackGasLimit", wireType)
+			}
+			m.ContractCallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractCallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowlistOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowlistOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowlistOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= QueryStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, types1.ResponseQuery{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIcq(dAtA[iNdEx:])
@@ -1,5 +1,7 @@
 package types
 
+import "encoding/binary"
+
 const (
 	// ModuleName defines the interchain query module name
 	ModuleName = "interchainquery"
@@ -7,9 +9,15 @@ const (
 	// PortID is the default port id that the interchain query module binds to
 	PortID = "icqhost"
 
+	// ControllerPortID is the default port id that the interchain query controller binds to
+	ControllerPortID = "icqcontroller"
+
 	// Version defines the current version for interchain query
 	Version = "icq-1"
 
+	// EncodingJSON is the encoding of the interchain query packet data of the IBC v2 payloads
+	EncodingJSON = "application/json"
+
 	// StoreKey is the store key string for interchain query
 	StoreKey = ModuleName
 
@@ -25,12 +33,84 @@ var (
 	ParamsKey = []byte{0x00}
 	// PortKey defines the key to store the port ID in store
 	PortKey = []byte{0x01}
+	// ControllerPortKey defines the key to store the controller port ID in store
+	ControllerPortKey = []byte{0x02}
+	// ActiveChannelKeyPrefix defines the prefix of the controller channel of each connection
+	ActiveChannelKeyPrefix = []byte{0x03}
+	// PendingQueryKeyPrefix defines the prefix of the queries awaiting their acknowledgement or timeout
+	PendingQueryKeyPrefix = []byte{0x04}
+	// QueryResultKeyPrefix defines the prefix of the results of the queries sent with MsgSendQuery
+	QueryResultKeyPrefix = []byte{0x05}
+	// ChannelAllowlistKeyPrefix defines the prefix of the allowlist overrides of the host channels
+	ChannelAllowlistKeyPrefix = []byte{0x06}
+	// ConnectionAllowlistKeyPrefix defines the prefix of the allowlist overrides of the connections
+	ConnectionAllowlistKeyPrefix = []byte{0x07}
+	// ClientAllowlistKeyPrefix defines the prefix of the allowlist overrides of the host clients
+	ClientAllowlistKeyPrefix = []byte{0x09}
+	// ChannelFeeEscrowKeyPrefix defines the prefix of the fee escrows of the host channels
+	ChannelFeeEscrowKeyPrefix = []byte{0x0a}
+	// ClientFeeEscrowKeyPrefix defines the prefix of the fee escrows of the host clients
+	ClientFeeEscrowKeyPrefix = []byte{0x0b}
 )
 
-// ContainsQueryPath returns true if the path is present in allowQueries, otherwise false
+// ActiveChannelKey returns the key of the controller channel of the connection
+func ActiveChannelKey(connectionID string) []byte {
+	return append(append([]byte{}, ActiveChannelKeyPrefix...), []byte(connectionID)...)
+}
+
+// PendingQueryKey returns the key of the query sent on the channel with the sequence
+func PendingQueryKey(channelID string, sequence uint64) []byte {
+	return queryKey(PendingQueryKeyPrefix, channelID, sequence)
+}
+
+// QueryResultKey returns the key of the result of the query sent on the channel with the sequence
+func QueryResultKey(channelID string, sequence uint64) []byte {
+	return queryKey(QueryResultKeyPrefix, channelID, sequence)
+}
+
+// AllowlistOverrideKey returns the key of the allowlist override of the host channel, else of the connection, else
+// of the host client
+func AllowlistOverrideKey(channelID, connectionID, clientID string) []byte {
+	switch {
+	case channelID != "":
+		return append(append([]byte{}, ChannelAllowlistKeyPrefix...), []byte(channelID)...)
+	case connectionID != "":
+		return append(append([]byte{}, ConnectionAllowlistKeyPrefix...), []byte(connectionID)...)
+	default:
+		return append(append([]byte{}, ClientAllowlistKeyPrefix...), []byte(clientID)...)
+	}
+}
+
+// FeeEscrowKey returns the key of the fee escrow of the depositor for the host channel, else for the host client
+func FeeEscrowKey(channelID, clientID, depositor string) []byte {
+	key := append([]byte{}, ClientFeeEscrowKeyPrefix...)
+	key = append(key, []byte(clientID)...)
+	if channelID != "" {
+		key = append(append([]byte{}, ChannelFeeEscrowKeyPrefix...), []byte(channelID)...)
+	}
+	key = append(key, '/')
+	return append(key, []byte(depositor)...)
+}
+
+func queryKey(prefix []byte, channelID string, sequence uint64) []byte {
+	key := append(append([]byte{}, prefix...), []byte(channelID)...)
+	key = append(key, '/')
+	return binary.BigEndian.AppendUint64(key, sequence)
+}
+
+// ParseQueryKey returns the channel and the sequence of a pending query or query result key, without its prefix
+func ParseQueryKey(key []byte) (channelID string, sequence uint64) {
+	if len(key) < 9 {
+		return "", 0
+	}
+	return string(key[:len(key)-9]), binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+// ContainsQueryPath returns true if the path is present in allowQueries, or matches one of its glob patterns,
+// otherwise false
 func ContainsQueryPath(allowQueries []string, path string) bool {
 	for _, v := range allowQueries {
-		if v == path {
+		if MatchQueryPath(v, path) {
 			return true
 		}
 	}
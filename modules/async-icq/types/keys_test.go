@@ -1,6 +1,6 @@
 package types_test
 
-import "github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+import "github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 
 func (suite *TypesTestSuite) TestContainsQueryPath() {
 	allowQueries := []string{
@@ -16,4 +16,21 @@ func (suite *TypesTestSuite) TestContainsQueryPath() {
 
 	found = types.ContainsQueryPath(allowQueries, "path/to/query3")
 	suite.Require().False(found)
+
+	allowQueries = []string{
+		"/cosmos.bank.v1beta1.Query/*",
+		"/store/*/key",
+	}
+
+	found = types.ContainsQueryPath(allowQueries, "/cosmos.bank.v1beta1.Query/AllBalances")
+	suite.Require().True(found)
+
+	found = types.ContainsQueryPath(allowQueries, "/store/bank/key")
+	suite.Require().True(found)
+
+	found = types.ContainsQueryPath(allowQueries, "/cosmos.staking.v1beta1.Query/Validators")
+	suite.Require().False(found)
+
+	found = types.ContainsQueryPath(allowQueries, "/store/bank/subspace")
+	suite.Require().False(found)
 }
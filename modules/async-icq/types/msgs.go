@@ -4,9 +4,20 @@ import (
 	"cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSendQuery{}
+	_ sdk.Msg = &MsgSetAllowlistOverride{}
+	_ sdk.Msg = &MsgRemoveAllowlistOverride{}
+	_ sdk.Msg = &MsgFundFeeEscrow{}
+	_ sdk.Msg = &MsgWithdrawFeeEscrow{}
+)
 
 // GetSignBytes implements the LegacyMsg interface.
 func (m MsgUpdateParams) GetSignBytes() []byte {
@@ -27,3 +38,203 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 
 	return m.Params.Validate()
 }
+
+// NewMsgSendQuery creates a new MsgSendQuery instance
+func NewMsgSendQuery(sender, channelID string, requests []abci.RequestQuery, timeout uint64) *MsgSendQuery {
+	return &MsgSendQuery{
+		Sender:    sender,
+		ChannelId: channelID,
+		Requests:  requests,
+		Timeout:   timeout,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSendQuery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSendQuery message.
+func (m *MsgSendQuery) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSendQuery) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errors.Wrap(err, "invalid sender address")
+	}
+	if err := host.ChannelIdentifierValidator(m.ChannelId); err != nil {
+		return errors.Wrap(err, "invalid channel id")
+	}
+	if len(m.Requests) == 0 {
+		return errors.Wrap(ErrInvalidQuery, "no query requests")
+	}
+	for _, req := range m.Requests {
+		if req.Path == "" {
+			return errors.Wrap(ErrInvalidQuery, "empty query path")
+		}
+	}
+	if m.Timeout == 0 {
+		return errors.Wrap(ErrInvalidQuery, "timeout must be positive")
+	}
+	if err := m.Fee.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidFee, "%s", err)
+	}
+	if m.Fee.IsZero() != (m.FeeEscrow == "") {
+		return errors.Wrap(ErrInvalidFee, "the fee and the fee escrow must be set together")
+	}
+	if m.FeeEscrow != "" {
+		if err := (FeeMemo{Escrow: m.FeeEscrow, Payer: m.Sender, MaxFee: m.Fee}).Validate(); err != nil {
+			return errors.Wrapf(ErrInvalidFee, "%s", err)
+		}
+	}
+	return nil
+}
+
+// NewMsgSetAllowlistOverride creates a new MsgSetAllowlistOverride instance
+func NewMsgSetAllowlistOverride(authority string, override AllowlistOverride) *MsgSetAllowlistOverride {
+	return &MsgSetAllowlistOverride{
+		Authority: authority,
+		Override:  override,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetAllowlistOverride) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetAllowlistOverride message.
+func (m *MsgSetAllowlistOverride) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetAllowlistOverride) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return m.Override.Validate()
+}
+
+// NewMsgRemoveAllowlistOverride creates a new MsgRemoveAllowlistOverride instance
+func NewMsgRemoveAllowlistOverride(authority, channelID, connectionID string) *MsgRemoveAllowlistOverride {
+	return &MsgRemoveAllowlistOverride{
+		Authority:    authority,
+		ChannelId:    channelID,
+		ConnectionId: connectionID,
+	}
+}
+
+// NewMsgRemoveClientAllowlistOverride creates a new MsgRemoveAllowlistOverride instance removing the allowlist of
+// a host client
+func NewMsgRemoveClientAllowlistOverride(authority, clientID string) *MsgRemoveAllowlistOverride {
+	return &MsgRemoveAllowlistOverride{
+		Authority: authority,
+		ClientId:  clientID,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemoveAllowlistOverride) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveAllowlistOverride message.
+func (m *MsgRemoveAllowlistOverride) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRemoveAllowlistOverride) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateAllowlistTarget(m.ChannelId, m.ConnectionId, m.ClientId)
+}
+
+// NewMsgFundFeeEscrow creates a new MsgFundFeeEscrow instance funding the fee escrow of the depositor for a host
+// channel, which pays for the queries of the payer, or of any sender if it is empty
+func NewMsgFundFeeEscrow(depositor, channelID, payer string, amount sdk.Coins) *MsgFundFeeEscrow {
+	return &MsgFundFeeEscrow{
+		Depositor: depositor,
+		ChannelId: channelID,
+		Payer:     payer,
+		Amount:    amount,
+	}
+}
+
+// NewMsgFundClientFeeEscrow creates a new MsgFundFeeEscrow instance funding the fee escrow of the depositor for a
+// host client
+func NewMsgFundClientFeeEscrow(depositor, clientID, payer string, amount sdk.Coins) *MsgFundFeeEscrow {
+	return &MsgFundFeeEscrow{
+		Depositor: depositor,
+		ClientId:  clientID,
+		Payer:     payer,
+		Amount:    amount,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgFundFeeEscrow) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgFundFeeEscrow message.
+func (m *MsgFundFeeEscrow) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Depositor)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgFundFeeEscrow) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Depositor); err != nil {
+		return errors.Wrap(err, "invalid depositor address")
+	}
+	if err := ValidateFeeEscrowTarget(m.ChannelId, m.ClientId); err != nil {
+		return err
+	}
+	if err := m.Amount.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidFee, "%s", err)
+	}
+	if m.Amount.IsZero() {
+		return errors.Wrap(ErrInvalidFee, "amount must be positive")
+	}
+	return nil
+}
+
+// NewMsgWithdrawFeeEscrow creates a new MsgWithdrawFeeEscrow instance withdrawing the fee escrow of the depositor for
+// a host channel, else for a host client
+func NewMsgWithdrawFeeEscrow(depositor, channelID, clientID string) *MsgWithdrawFeeEscrow {
+	return &MsgWithdrawFeeEscrow{
+		Depositor: depositor,
+		ChannelId: channelID,
+		ClientId:  clientID,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgWithdrawFeeEscrow) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgWithdrawFeeEscrow message.
+func (m *MsgWithdrawFeeEscrow) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Depositor)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgWithdrawFeeEscrow) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Depositor); err != nil {
+		return errors.Wrap(err, "invalid depositor address")
+	}
+
+	return ValidateFeeEscrowTarget(m.ChannelId, m.ClientId)
+}
@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 	types "github.com/cometbft/cometbft/abci/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -171,6 +173,9 @@ func (m *CosmosQuery) GetRequests() []types.RequestQuery {
 // CosmosResponse contains a list of tendermint ABCI query responses. It should be used when receiving responses from an SDK host chain.
 type CosmosResponse struct {
 	Responses []types.ResponseQuery `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses"`
+	// fee is the fee charged by the host for the queries from the fee escrow named in the memo of the packet data, at
+	// most the maximum fee declared in the memo.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
 }
 
 func (m *CosmosResponse) Reset()         { *m = CosmosResponse{} }
@@ -213,6 +218,13 @@ func (m *CosmosResponse) GetResponses() []types.ResponseQuery {
 	return nil
 }
 
+func (m *CosmosResponse) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*InterchainQueryPacketData)(nil), "icq.v1.InterchainQueryPacketData")
 	proto.RegisterType((*InterchainQueryPacketAck)(nil), "icq.v1.InterchainQueryPacketAck")
@@ -223,27 +235,31 @@ func init() {
 func init() { proto.RegisterFile("icq/v1/packet.proto", fileDescriptor_13b1ec1d226ce757) }
 
 var fileDescriptor_13b1ec1d226ce757 = []byte{
-	// 316 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4e, 0x32, 0x31,
-	0x14, 0xc5, 0xa7, 0xdf, 0x47, 0x88, 0x14, 0xe3, 0x62, 0x74, 0x31, 0x62, 0xac, 0x64, 0x56, 0x6c,
-	0x68, 0x83, 0xc6, 0xc4, 0x9d, 0x11, 0xdc, 0xb8, 0xf1, 0xcf, 0xc4, 0x95, 0xbb, 0x4e, 0xb9, 0x81,
-	0x06, 0x67, 0x5a, 0xda, 0x0e, 0x09, 0x6f, 0xe1, 0x63, 0xb1, 0x64, 0xe9, 0xca, 0x18, 0x78, 0x11,
-	0x43, 0xab, 0xe2, 0x82, 0xdd, 0xc9, 0xbd, 0xe7, 0xfc, 0x7a, 0x73, 0x8a, 0x0f, 0xa5, 0x98, 0xb2,
-	0x59, 0x8f, 0x69, 0x2e, 0x26, 0xe0, 0xa8, 0x36, 0xca, 0xa9, 0xb8, 0x2e, 0xc5, 0x94, 0xce, 0x7a,
-	0xad, 0xa3, 0x91, 0x1a, 0x29, 0x3f, 0x62, 0x1b, 0x15, 0xb6, 0xad, 0x13, 0x07, 0xe5, 0x10, 0x4c,
-	0x21, 0x4b, 0xc7, 0x78, 0x2e, 0x24, 0x73, 0x73, 0x0d, 0x36, 0x2c, 0xd3, 0x01, 0x3e, 0xbe, 0x2b,
-	0x1d, 0x18, 0x31, 0xe6, 0xb2, 0x7c, 0xaa, 0xc0, 0xcc, 0x1f, 0x3d, 0xf9, 0x96, 0x3b, 0x1e, 0xc7,
-	0xb8, 0x36, 0xe4, 0x8e, 0x27, 0xa8, 0x8d, 0x3a, 0xfb, 0x99, 0xd7, 0x9b, 0x59, 0x01, 0x85, 0x4a,
-	0xfe, 0xb5, 0x51, 0xa7, 0x91, 0x79, 0x9d, 0x52, 0x9c, 0xec, 0x84, 0xdc, 0x88, 0xc9, 0x2e, 0x46,
-	0x7a, 0x8f, 0x9b, 0x03, 0x65, 0x0b, 0x65, 0xbd, 0x37, 0xbe, 0xc6, 0x7b, 0x06, 0xa6, 0x15, 0x58,
-	0x67, 0x13, 0xd4, 0xfe, 0xdf, 0x69, 0x9e, 0x9f, 0xd2, 0xed, 0xcd, 0x74, 0x73, 0x33, 0xcd, 0x82,
-	0xc1, 0x07, 0xfa, 0xb5, 0xc5, 0xc7, 0x59, 0x94, 0xfd, 0x86, 0xd2, 0x67, 0x7c, 0x10, 0x78, 0x19,
-	0x58, 0xad, 0x4a, 0x0b, 0x71, 0x1f, 0x37, 0xcc, 0xb7, 0xfe, 0x61, 0x92, 0x1d, 0xcc, 0xe0, 0xf8,
-	0x0b, 0xdd, 0xc6, 0xfa, 0x0f, 0x8b, 0x15, 0x41, 0xcb, 0x15, 0x41, 0x9f, 0x2b, 0x82, 0xde, 0xd6,
-	0x24, 0x5a, 0xae, 0x49, 0xf4, 0xbe, 0x26, 0xd1, 0xcb, 0xe5, 0x48, 0xba, 0x71, 0x95, 0x53, 0xa1,
-	0x0a, 0x26, 0xfc, 0xc3, 0x4c, 0xe6, 0xa2, 0xcb, 0xb5, 0xb6, 0xac, 0x50, 0xc3, 0xea, 0x15, 0x2c,
-	0xe3, 0x76, 0x5e, 0x8a, 0xae, 0xff, 0xad, 0xab, 0xd0, 0x78, 0x5e, 0xf7, 0x95, 0x5f, 0x7c, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x9f, 0x59, 0xe8, 0x08, 0xc4, 0x01, 0x00, 0x00,
+	// 378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xb1, 0xce, 0xd3, 0x30,
+	0x10, 0xc7, 0xe3, 0xef, 0xab, 0x3e, 0xf1, 0xb9, 0x88, 0x21, 0x30, 0xa4, 0x45, 0xb8, 0x55, 0xa6,
+	0x2c, 0xb5, 0x1b, 0x90, 0x58, 0x11, 0x29, 0x0b, 0x0b, 0x2a, 0x19, 0x91, 0x18, 0x1c, 0xe7, 0x68,
+	0xad, 0x12, 0x3b, 0x8d, 0x9d, 0x4a, 0x7d, 0x0b, 0x9e, 0x83, 0x95, 0x97, 0xe8, 0xd8, 0x91, 0x09,
+	0x50, 0xfb, 0x22, 0x28, 0x76, 0xa0, 0x48, 0x74, 0xf2, 0xe9, 0xee, 0xfe, 0x3f, 0xfb, 0xfe, 0x67,
+	0xfc, 0x58, 0x8a, 0x2d, 0xdb, 0xa5, 0xac, 0xe6, 0x62, 0x03, 0x96, 0xd6, 0x8d, 0xb6, 0x3a, 0xbc,
+	0x93, 0x62, 0x4b, 0x77, 0xe9, 0xf8, 0xc9, 0x4a, 0xaf, 0xb4, 0x4b, 0xb1, 0x2e, 0xf2, 0xd5, 0xf1,
+	0x53, 0x0b, 0xaa, 0x84, 0xa6, 0x92, 0xca, 0x32, 0x5e, 0x08, 0xc9, 0xec, 0xbe, 0x06, 0xd3, 0x17,
+	0x89, 0xd0, 0xa6, 0xd2, 0x86, 0x15, 0xdc, 0x00, 0xdb, 0xa5, 0x05, 0x58, 0x9e, 0x32, 0xa1, 0xa5,
+	0xf2, 0xf5, 0x78, 0x81, 0x47, 0x6f, 0x95, 0x85, 0x46, 0xac, 0xb9, 0x54, 0xef, 0x5b, 0x68, 0xf6,
+	0x4b, 0x77, 0xf3, 0x1b, 0x6e, 0x79, 0x18, 0xe2, 0x41, 0xc9, 0x2d, 0x8f, 0xd0, 0x14, 0x25, 0x0f,
+	0xf3, 0x41, 0xd9, 0xe7, 0x2a, 0xa8, 0x74, 0x74, 0x33, 0x45, 0xc9, 0x7d, 0xee, 0xe2, 0x98, 0xe2,
+	0xe8, 0x2a, 0xe4, 0xb5, 0xd8, 0x5c, 0x63, 0xc4, 0xef, 0xf0, 0x70, 0xe1, 0x9e, 0xe5, 0x7a, 0xc3,
+	0x57, 0xf8, 0x41, 0x03, 0xdb, 0x16, 0x8c, 0x35, 0x11, 0x9a, 0xde, 0x26, 0xc3, 0xe7, 0xcf, 0xe8,
+	0x65, 0x26, 0xda, 0xcd, 0x44, 0x73, 0xdf, 0xe0, 0x04, 0xd9, 0xe0, 0xf0, 0x63, 0x12, 0xe4, 0x7f,
+	0x45, 0xf1, 0x37, 0x84, 0x1f, 0x79, 0x60, 0x0e, 0xa6, 0xd6, 0xca, 0x40, 0x98, 0xe1, 0xfb, 0xa6,
+	0x8f, 0xff, 0x40, 0xc9, 0x15, 0xa8, 0xef, 0xf8, 0x97, 0x7a, 0x91, 0x85, 0x1f, 0xf1, 0xed, 0x27,
+	0x80, 0xe8, 0xc6, 0xa9, 0x47, 0xd4, 0x3b, 0x49, 0x3b, 0x27, 0x69, 0xef, 0x24, 0x5d, 0x68, 0xa9,
+	0xb2, 0x79, 0x27, 0xfc, 0xfa, 0x73, 0x92, 0xac, 0xa4, 0x5d, 0xb7, 0x05, 0x15, 0xba, 0x62, 0xbd,
+	0xed, 0xfe, 0x98, 0x99, 0x72, 0xd3, 0x6f, 0xa5, 0x13, 0x98, 0xbc, 0xe3, 0x66, 0xcb, 0xc3, 0x89,
+	0xa0, 0xe3, 0x89, 0xa0, 0x5f, 0x27, 0x82, 0xbe, 0x9c, 0x49, 0x70, 0x3c, 0x93, 0xe0, 0xfb, 0x99,
+	0x04, 0x1f, 0x5e, 0xfe, 0x0f, 0x92, 0x85, 0x98, 0xf1, 0xba, 0x36, 0xac, 0xd2, 0x65, 0xfb, 0x19,
+	0x0c, 0xe3, 0x66, 0xaf, 0xc4, 0xcc, 0xff, 0x96, 0xb9, 0x87, 0x17, 0x77, 0x6e, 0xa7, 0x2f, 0x7e,
+	0x0f, 0x00, 0xf1, 0x27, 0xd1, 0xf5, 0x45, 0x02, 0x00, 0x00,
 }
 
 func (m *InterchainQueryPacketData) Marshal() (dAtA []byte, err error) {
@@ -370,6 +386,20 @@ func (m *CosmosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Responses) > 0 {
 		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -455,6 +485,12 @@ func (m *CosmosResponse) Size() (n int) {
 			n += 1 + l + sovPacket(uint64(l))
 		}
 	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -811,6 +847,40 @@ func (m *CosmosResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types1.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -3,14 +3,26 @@ package types
 import (
 	"fmt"
 	"strings"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
 	// DefaultHostEnabled is the default value for the host param (set to true)
 	DefaultHostEnabled = true
+	// DefaultMaxRequestsPerPacket is the default maximum number of query requests in a packet
+	DefaultMaxRequestsPerPacket uint64 = 32
+	// DefaultMaxGasPerPacket is the default gas limit of the queries of a packet
+	DefaultMaxGasPerPacket uint64 = 3_000_000
+	// DefaultMaxResponseBytes is the default maximum size of the responses to the queries of a packet
+	DefaultMaxResponseBytes uint64 = 1 << 20
+	// DefaultContractCallbackGasLimit is the default gas available to a contract to process the result of its query
+	DefaultContractCallbackGasLimit uint64 = 1_000_000
 )
 
-// NewParams creates a new parameter configuration
+// NewParams creates a new parameter configuration, without packet limits
 func NewParams(enableHost bool, allowQueries []string) Params {
 	return Params{
 		HostEnabled:  enableHost,
@@ -20,7 +32,12 @@ func NewParams(enableHost bool, allowQueries []string) Params {
 
 // DefaultParams is the default parameter configuration
 func DefaultParams() Params {
-	return NewParams(DefaultHostEnabled, nil)
+	params := NewParams(DefaultHostEnabled, nil)
+	params.MaxRequestsPerPacket = DefaultMaxRequestsPerPacket
+	params.MaxGasPerPacket = DefaultMaxGasPerPacket
+	params.MaxResponseBytes = DefaultMaxResponseBytes
+	params.ContractCallbackGasLimit = DefaultContractCallbackGasLimit
+	return params
 }
 
 // Validate validates all parameters
@@ -28,7 +45,10 @@ func (p Params) Validate() error {
 	if err := validateEnabled(p.HostEnabled); err != nil {
 		return err
 	}
-	return validateAllowlist(p.AllowQueries)
+	if err := validateAllowlist(p.AllowQueries); err != nil {
+		return err
+	}
+	return validateQueryFees(p.QueryFees, p.FeeRecipient)
 }
 
 func validateEnabled(i interface{}) error {
@@ -50,7 +70,25 @@ func validateAllowlist(i interface{}) error {
 		if strings.TrimSpace(path) == "" {
 			return fmt.Errorf("parameter must not contain empty strings: %s", allowQueries)
 		}
+		if err := ValidateQueryPattern(path); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
+
+func validateQueryFees(queryFees []QueryFee, feeRecipient string) error {
+	for _, queryFee := range queryFees {
+		if err := queryFee.Validate(); err != nil {
+			return err
+		}
+	}
+	if feeRecipient == "" && len(queryFees) == 0 {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(feeRecipient); err != nil {
+		return errors.Wrapf(ErrInvalidFee, "invalid fee recipient: %s", err)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -111,32 +112,394 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryQueryResultRequest is the request type for the Query/QueryResult RPC method.
+type QueryQueryResultRequest struct {
+	// channel_id is the controller channel the query was sent on.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence of the query packet.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryQueryResultRequest) Reset()         { *m = QueryQueryResultRequest{} }
+func (m *QueryQueryResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueryResultRequest) ProtoMessage()    {}
+func (*QueryQueryResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34e65615f053d386, []int{2}
+}
+func (m *QueryQueryResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueryResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueryResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueryResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueryResultRequest.Merge(m, src)
+}
+func (m *QueryQueryResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueryResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueryResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueryResultRequest proto.InternalMessageInfo
+
+func (m *QueryQueryResultRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryQueryResultRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryQueryResultResponse is the response type for the Query/QueryResult RPC method.
+type QueryQueryResultResponse struct {
+	Result QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
+	// decoded_responses are the JSON encoded response messages of the successful
+	// responses, in order. A response is empty if it failed, or if its type isn't
+	// known by this chain.
+	DecodedResponses []string `protobuf:"bytes,2,rep,name=decoded_responses,json=decodedResponses,proto3" json:"decoded_responses,omitempty"`
+}
+
+func (m *QueryQueryResultResponse) Reset()         { *m = QueryQueryResultResponse{} }
+func (m *QueryQueryResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueryResultResponse) ProtoMessage()    {}
+func (*QueryQueryResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34e65615f053d386, []int{3}
+}
+func (m *QueryQueryResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueryResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueryResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueryResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueryResultResponse.Merge(m, src)
+}
+func (m *QueryQueryResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueryResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueryResultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueryResultResponse proto.InternalMessageInfo
+
+func (m *QueryQueryResultResponse) GetResult() QueryResult {
+	if m != nil {
+		return m.Result
+	}
+	return QueryResult{}
+}
+
+func (m *QueryQueryResultResponse) GetDecodedResponses() []string {
+	if m != nil {
+		return m.DecodedResponses
+	}
+	return nil
+}
+
+// QueryEffectiveAllowlistRequest is the request type for the Query/EffectiveAllowlist RPC method.
+type QueryEffectiveAllowlistRequest struct {
+	// channel_id is the host channel. Exactly one of channel_id and client_id is set.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// client_id is the host client receiving IBC v2 packets.
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryEffectiveAllowlistRequest) Reset()         { *m = QueryEffectiveAllowlistRequest{} }
+func (m *QueryEffectiveAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveAllowlistRequest) ProtoMessage()    {}
+func (*QueryEffectiveAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34e65615f053d386, []int{4}
+}
+func (m *QueryEffectiveAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveAllowlistRequest.Merge(m, src)
+}
+func (m *QueryEffectiveAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveAllowlistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveAllowlistRequest proto.InternalMessageInfo
+
+func (m *QueryEffectiveAllowlistRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryEffectiveAllowlistRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryEffectiveAllowlistResponse is the response type for the Query/EffectiveAllowlist RPC method.
+type QueryEffectiveAllowlistResponse struct {
+	// allow_queries are the query paths or glob patterns allowed on the channel or client.
+	AllowQueries []string `protobuf:"bytes,1,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty"`
+	// source is where the allowlist is set: "channel", "connection" or "client"
+	// for an allowlist override, or "params".
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (m *QueryEffectiveAllowlistResponse) Reset()         { *m = QueryEffectiveAllowlistResponse{} }
+func (m *QueryEffectiveAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveAllowlistResponse) ProtoMessage()    {}
+func (*QueryEffectiveAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34e65615f053d386, []int{5}
+}
+func (m *QueryEffectiveAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveAllowlistResponse.Merge(m, src)
+}
+func (m *QueryEffectiveAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveAllowlistResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveAllowlistResponse) GetAllowQueries() []string {
+	if m != nil {
+		return m.AllowQueries
+	}
+	return nil
+}
+
+func (m *QueryEffectiveAllowlistResponse) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+// QueryFeeEscrowRequest is the request type for the Query/FeeEscrow RPC method.
+type QueryFeeEscrowRequest struct {
+	// channel_id is the host channel. Exactly one of channel_id and client_id is set.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// client_id is the host client receiving IBC v2 packets.
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// depositor is the account which funded the escrow.
+	Depositor string `protobuf:"bytes,3,opt,name=depositor,proto3" json:"depositor,omitempty"`
+}
+
+func (m *QueryFeeEscrowRequest) Reset()         { *m = QueryFeeEscrowRequest{} }
+func (m *QueryFeeEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeEscrowRequest) ProtoMessage()    {}
+func (*QueryFeeEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34e65615f053d386, []int{6}
+}
+func (m *QueryFeeEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeEscrowRequest.Merge(m, src)
+}
+func (m *QueryFeeEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeEscrowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeEscrowRequest proto.InternalMessageInfo
+
+func (m *QueryFeeEscrowRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryFeeEscrowRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryFeeEscrowRequest) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+// QueryFeeEscrowResponse is the response type for the Query/FeeEscrow RPC method.
+type QueryFeeEscrowResponse struct {
+	FeeEscrow FeeEscrow `protobuf:"bytes,1,opt,name=fee_escrow,json=feeEscrow,proto3" json:"fee_escrow"`
+}
+
+func (m *QueryFeeEscrowResponse) Reset()         { *m = QueryFeeEscrowResponse{} }
+func (m *QueryFeeEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeEscrowResponse) ProtoMessage()    {}
+func (*QueryFeeEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34e65615f053d386, []int{7}
+}
+func (m *QueryFeeEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeEscrowResponse.Merge(m, src)
+}
+func (m *QueryFeeEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeEscrowResponse proto.InternalMessageInfo
+
+func (m *QueryFeeEscrowResponse) GetFeeEscrow() FeeEscrow {
+	if m != nil {
+		return m.FeeEscrow
+	}
+	return FeeEscrow{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "icq.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "icq.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryQueryResultRequest)(nil), "icq.v1.QueryQueryResultRequest")
+	proto.RegisterType((*QueryQueryResultResponse)(nil), "icq.v1.QueryQueryResultResponse")
+	proto.RegisterType((*QueryEffectiveAllowlistRequest)(nil), "icq.v1.QueryEffectiveAllowlistRequest")
+	proto.RegisterType((*QueryEffectiveAllowlistResponse)(nil), "icq.v1.QueryEffectiveAllowlistResponse")
+	proto.RegisterType((*QueryFeeEscrowRequest)(nil), "icq.v1.QueryFeeEscrowRequest")
+	proto.RegisterType((*QueryFeeEscrowResponse)(nil), "icq.v1.QueryFeeEscrowResponse")
 }
 
 func init() { proto.RegisterFile("icq/v1/query.proto", fileDescriptor_34e65615f053d386) }
 
 var fileDescriptor_34e65615f053d386 = []byte{
-	// 265 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xca, 0x4c, 0x2e, 0xd4,
-	0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62,
-	0xcb, 0x4c, 0x2e, 0xd4, 0x2b, 0x33, 0x94, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f,
-	0x2c, 0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0xa8,
-	0x92, 0x12, 0x80, 0xea, 0x04, 0x29, 0x06, 0x8b, 0x28, 0x89, 0x70, 0x09, 0x05, 0x82, 0x8c, 0x09,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x0e, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0xb2, 0xe5, 0x12,
-	0x46, 0x11, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0x52, 0xe3, 0x62, 0x2b, 0x00, 0x8b, 0x48,
-	0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xf1, 0xe9, 0x41, 0x6c, 0xd5, 0x83, 0xaa, 0x83, 0xca, 0x1a,
-	0x65, 0x70, 0xb1, 0x82, 0xb5, 0x0b, 0xc5, 0x73, 0xb1, 0x41, 0xa4, 0x84, 0xa4, 0x60, 0x4a, 0x31,
-	0x6d, 0x93, 0x92, 0xc6, 0x2a, 0x07, 0xb1, 0x53, 0x49, 0xa6, 0xe9, 0xf2, 0x93, 0xc9, 0x4c, 0x62,
-	0x42, 0x22, 0xfa, 0x89, 0xc5, 0x95, 0x79, 0xc9, 0xba, 0x50, 0x1f, 0x40, 0x6c, 0x72, 0xf2, 0x3f,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xd3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0xfd, 0xcc, 0xa4, 0x64,
-	0xdd, 0xc4, 0x82, 0x82, 0x62, 0xfd, 0xdc, 0xfc, 0x94, 0xd2, 0x9c, 0xd4, 0x62, 0x64, 0x13, 0x2d,
-	0xf4, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xc1, 0x62, 0x0c, 0x08, 0x00, 0x00, 0xff,
-	0xff, 0xe2, 0xa5, 0x54, 0x52, 0x64, 0x01, 0x00, 0x00,
+	// 659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x4f, 0x13, 0x5d,
+	0x14, 0xed, 0x14, 0xbe, 0x86, 0xb9, 0x7c, 0x1a, 0xb8, 0x20, 0x36, 0x03, 0x0c, 0xcd, 0x68, 0x90,
+	0xa8, 0xf4, 0x09, 0x46, 0x62, 0x4c, 0x5c, 0x48, 0x82, 0x09, 0x3b, 0x98, 0xb0, 0x6a, 0x8c, 0xcd,
+	0x30, 0xf3, 0x5a, 0x26, 0x99, 0xce, 0x9b, 0xce, 0x9b, 0x96, 0x60, 0xd3, 0x8d, 0x1b, 0xb7, 0x26,
+	0xfe, 0x22, 0x5d, 0xb1, 0x24, 0x71, 0xe3, 0xca, 0x18, 0xf0, 0x5f, 0xb8, 0x31, 0xf3, 0xe6, 0x75,
+	0xda, 0x47, 0x69, 0x74, 0xe1, 0xa6, 0x99, 0x39, 0xf7, 0xdc, 0x73, 0xcf, 0xed, 0x3d, 0x2d, 0xa0,
+	0xef, 0xb6, 0x49, 0x77, 0x8b, 0xb4, 0x3b, 0x34, 0x3e, 0xab, 0x46, 0x31, 0x4b, 0x18, 0x96, 0x7c,
+	0xb7, 0x5d, 0xed, 0x6e, 0x19, 0x2b, 0x4d, 0xc6, 0x9a, 0x01, 0x25, 0x4e, 0xe4, 0x13, 0x27, 0x0c,
+	0x59, 0xe2, 0x24, 0x3e, 0x0b, 0x79, 0xc6, 0x32, 0xe6, 0x64, 0x67, 0x4a, 0xce, 0x90, 0xc5, 0x26,
+	0x6b, 0x32, 0xf1, 0x48, 0xd2, 0xa7, 0x0c, 0xb5, 0x16, 0x01, 0x0f, 0x53, 0xf1, 0x03, 0x27, 0x76,
+	0x5a, 0xdc, 0xa6, 0xed, 0x0e, 0xe5, 0x89, 0xf5, 0x12, 0x16, 0x14, 0x94, 0x47, 0x2c, 0xe4, 0x14,
+	0xd7, 0xa1, 0x14, 0x09, 0xa4, 0xac, 0x55, 0xb4, 0x8d, 0xd9, 0xed, 0xdb, 0xd5, 0xcc, 0x4b, 0x55,
+	0xf2, 0x64, 0xd5, 0x3a, 0x82, 0xbb, 0xa2, 0x5d, 0x7c, 0xd8, 0x94, 0x77, 0x82, 0x44, 0x2a, 0xe3,
+	0x2a, 0x80, 0x7b, 0xe2, 0x84, 0x21, 0x0d, 0xea, 0xbe, 0x27, 0x64, 0x74, 0x5b, 0x97, 0xc8, 0xbe,
+	0x87, 0x06, 0xcc, 0xf0, 0x94, 0x19, 0xba, 0xb4, 0x5c, 0xac, 0x68, 0x1b, 0xd3, 0x76, 0xfe, 0x6e,
+	0xbd, 0x83, 0xf2, 0xb8, 0xaa, 0x74, 0xb6, 0x05, 0xa5, 0x58, 0x20, 0xd2, 0xd9, 0xc2, 0xc0, 0xd9,
+	0x08, 0x79, 0x77, 0xfa, 0xfc, 0xfb, 0x5a, 0xc1, 0x96, 0x44, 0x7c, 0x04, 0xf3, 0x1e, 0x75, 0x99,
+	0x47, 0xbd, 0x7a, 0x2c, 0x65, 0x78, 0xb9, 0x58, 0x99, 0xda, 0xd0, 0xed, 0x39, 0x59, 0x18, 0xc8,
+	0x73, 0xeb, 0x0d, 0x98, 0x42, 0x69, 0xaf, 0xd1, 0xa0, 0x6e, 0xe2, 0x77, 0xe9, 0xab, 0x20, 0x60,
+	0xa7, 0x81, 0xcf, 0xff, 0x76, 0xb1, 0x65, 0xd0, 0xdd, 0xc0, 0xa7, 0x61, 0x92, 0x56, 0x8b, 0xa2,
+	0x3a, 0x93, 0x01, 0xfb, 0x9e, 0xf5, 0x16, 0xd6, 0x26, 0xaa, 0xcb, 0x05, 0xef, 0xc1, 0x2d, 0x27,
+	0x05, 0xeb, 0x69, 0x14, 0x7c, 0x9a, 0x5e, 0x20, 0x75, 0xfa, 0xbf, 0x00, 0x0f, 0x33, 0x0c, 0x97,
+	0xa0, 0xc4, 0x59, 0x27, 0x96, 0xdf, 0x9d, 0x6e, 0xcb, 0x37, 0xab, 0x0d, 0x77, 0x84, 0xfe, 0x6b,
+	0x4a, 0xf7, 0xb8, 0x1b, 0xb3, 0xd3, 0x7f, 0x60, 0x1a, 0x57, 0x40, 0xf7, 0x68, 0xc4, 0xb8, 0x9f,
+	0xb0, 0xb8, 0x3c, 0x95, 0xb5, 0xe6, 0x80, 0x75, 0x00, 0x4b, 0xd7, 0x47, 0xca, 0x4d, 0x76, 0x00,
+	0x1a, 0x94, 0xd6, 0xa9, 0x40, 0xe5, 0xb9, 0xe6, 0x07, 0xe7, 0xca, 0xe9, 0xf2, 0x58, 0x7a, 0x63,
+	0x00, 0x6c, 0xff, 0x9a, 0x86, 0xff, 0x84, 0x24, 0xd6, 0xa1, 0x94, 0x05, 0x0e, 0x0d, 0xe5, 0xcc,
+	0x4a, 0x86, 0x8d, 0xe5, 0x1b, 0x6b, 0x99, 0x09, 0x6b, 0xe5, 0xfd, 0xd7, 0x9f, 0x9f, 0x8a, 0x4b,
+	0xb8, 0x48, 0x1c, 0x7e, 0x16, 0xba, 0x9b, 0xf2, 0xd7, 0x92, 0xe5, 0x17, 0x3f, 0x68, 0x30, 0x3b,
+	0x12, 0x1c, 0x5c, 0x53, 0xa4, 0xc6, 0x53, 0x6d, 0x54, 0x26, 0x13, 0xe4, 0xc0, 0x67, 0x62, 0x20,
+	0xc1, 0x4d, 0x75, 0x60, 0x96, 0x45, 0x4e, 0x7a, 0xc3, 0x33, 0xf4, 0x49, 0x6f, 0x10, 0xf9, 0x3e,
+	0x7e, 0xd6, 0x00, 0xc7, 0x53, 0x81, 0xeb, 0xca, 0xbc, 0x89, 0xa1, 0x34, 0x1e, 0xfc, 0x91, 0x27,
+	0xed, 0xd5, 0x84, 0xbd, 0xa3, 0xda, 0x63, 0x7c, 0xa8, 0x1a, 0x94, 0xf7, 0x77, 0x06, 0x1d, 0xa4,
+	0x97, 0x27, 0xa2, 0x8f, 0xf7, 0x55, 0xee, 0x28, 0x69, 0xb8, 0x0e, 0x7e, 0xd1, 0x40, 0xcf, 0xef,
+	0x8a, 0xab, 0x8a, 0xa5, 0xeb, 0x89, 0x34, 0xcc, 0x49, 0x65, 0x69, 0xb4, 0x2b, 0x8c, 0x46, 0xb5,
+	0x17, 0xf8, 0xfc, 0x46, 0xa3, 0xc3, 0x68, 0xf1, 0x51, 0xab, 0xa4, 0x97, 0x47, 0xb3, 0x8f, 0xdb,
+	0x6a, 0xa7, 0xda, 0x32, 0x7a, 0x87, 0x61, 0xcf, 0xee, 0xc1, 0xf9, 0xa5, 0xa9, 0x5d, 0x5c, 0x9a,
+	0xda, 0x8f, 0x4b, 0x53, 0xfb, 0x78, 0x65, 0x16, 0x2e, 0xae, 0xcc, 0xc2, 0xb7, 0x2b, 0xb3, 0x50,
+	0xdb, 0x69, 0xfa, 0xc9, 0x49, 0xe7, 0xb8, 0xea, 0xb2, 0x16, 0x71, 0x19, 0x6f, 0x31, 0x4e, 0xfc,
+	0x63, 0x77, 0xd3, 0x89, 0x22, 0x4e, 0x5a, 0xcc, 0xeb, 0x04, 0x94, 0x2b, 0xf3, 0x9e, 0x90, 0xe4,
+	0x2c, 0xa2, 0xfc, 0xb8, 0x24, 0xfe, 0x80, 0x9f, 0xfe, 0x1e, 0x00, 0x53, 0xb6, 0xda, 0x19, 0xe4,
+	0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -153,6 +516,13 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries all parameters of the ICQ module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// QueryResult queries the result of a query sent with MsgSendQuery.
+	QueryResult(ctx context.Context, in *QueryQueryResultRequest, opts ...grpc.CallOption) (*QueryQueryResultResponse, error)
+	// EffectiveAllowlist queries the query paths allowed on a host channel, or on a host client with IBC v2.
+	EffectiveAllowlist(ctx context.Context, in *QueryEffectiveAllowlistRequest, opts ...grpc.CallOption) (*QueryEffectiveAllowlistResponse, error)
+	// FeeEscrow queries the balance escrowed by a depositor to pay the query fees of a host channel, or of a host
+	// client with IBC v2.
+	FeeEscrow(ctx context.Context, in *QueryFeeEscrowRequest, opts ...grpc.CallOption) (*QueryFeeEscrowResponse, error)
 }
 
 type queryClient struct {
@@ -172,10 +542,44 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) QueryResult(ctx context.Context, in *QueryQueryResultRequest, opts ...grpc.CallOption) (*QueryQueryResultResponse, error) {
+	out := new(QueryQueryResultResponse)
+	err := c.cc.Invoke(ctx, "/icq.v1.Query/QueryResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveAllowlist(ctx context.Context, in *QueryEffectiveAllowlistRequest, opts ...grpc.CallOption) (*QueryEffectiveAllowlistResponse, error) {
+	out := new(QueryEffectiveAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/icq.v1.Query/EffectiveAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeEscrow(ctx context.Context, in *QueryFeeEscrowRequest, opts ...grpc.CallOption) (*QueryFeeEscrowResponse, error) {
+	out := new(QueryFeeEscrowResponse)
+	err := c.cc.Invoke(ctx, "/icq.v1.Query/FeeEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the ICQ module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// QueryResult queries the result of a query sent with MsgSendQuery.
+	QueryResult(context.Context, *QueryQueryResultRequest) (*QueryQueryResultResponse, error)
+	// EffectiveAllowlist queries the query paths allowed on a host channel, or on a host client with IBC v2.
+	EffectiveAllowlist(context.Context, *QueryEffectiveAllowlistRequest) (*QueryEffectiveAllowlistResponse, error)
+	// FeeEscrow queries the balance escrowed by a depositor to pay the query fees of a host channel, or of a host
+	// client with IBC v2.
+	FeeEscrow(context.Context, *QueryFeeEscrowRequest) (*QueryFeeEscrowResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -185,6 +589,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) QueryResult(ctx context.Context, req *QueryQueryResultRequest) (*QueryQueryResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryResult not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveAllowlist(ctx context.Context, req *QueryEffectiveAllowlistRequest) (*QueryEffectiveAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveAllowlist not implemented")
+}
+func (*UnimplementedQueryServer) FeeEscrow(ctx context.Context, req *QueryFeeEscrowRequest) (*QueryFeeEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeEscrow not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -208,6 +621,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueryResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/icq.v1.Query/QueryResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryResult(ctx, req.(*QueryQueryResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/icq.v1.Query/EffectiveAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveAllowlist(ctx, req.(*QueryEffectiveAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/icq.v1.Query/FeeEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeEscrow(ctx, req.(*QueryFeeEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "icq.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -216,6 +683,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "QueryResult",
+			Handler:    _Query_QueryResult_Handler,
+		},
+		{
+			MethodName: "EffectiveAllowlist",
+			Handler:    _Query_EffectiveAllowlist_Handler,
+		},
+		{
+			MethodName: "FeeEscrow",
+			Handler:    _Query_FeeEscrow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "icq/v1/query.proto",
@@ -279,16 +758,246 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryQueryResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueryResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueryResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueryResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueryResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueryResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DecodedResponses) > 0 {
+		for iNdEx := len(m.DecodedResponses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DecodedResponses[iNdEx])
+			copy(dAtA[i:], m.DecodedResponses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.DecodedResponses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AllowQueries) > 0 {
+		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowQueries[iNdEx])
+			copy(dAtA[i:], m.AllowQueries[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeEscrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
@@ -312,6 +1021,107 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryQueryResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryQueryResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.DecodedResponses) > 0 {
+		for _, s := range m.DecodedResponses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEffectiveAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEffectiveAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowQueries) > 0 {
+		for _, s := range m.AllowQueries {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeEscrow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -454,6 +1264,679 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryQueryResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueryResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueryResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueryResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueryResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueryResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedResponses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodedResponses = append(m.DecodedResponses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeEscrowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeEscrowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEscrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeEscrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// NewQueryMessages returns new instances of the request and response messages of the gRPC query method at the
+// path, e.g. /cosmos.bank.v1beta1.Query/AllBalances
+func NewQueryMessages(path string) (request, response proto.Message, err error) {
+	service, method, found := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	if !found || service == "" || method == "" {
+		return nil, nil, fmt.Errorf("invalid query path %s", path)
+	}
+
+	descriptor, err := proto.HybridResolver.FindDescriptorByName(protoreflect.FullName(service + "." + method))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unknown query method %s: %w", path, err)
+	}
+	methodDescriptor, ok := descriptor.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s is not a query method", path)
+	}
+
+	if request, err = newMessage(methodDescriptor.Input().FullName()); err != nil {
+		return nil, nil, err
+	}
+	if response, err = newMessage(methodDescriptor.Output().FullName()); err != nil {
+		return nil, nil, err
+	}
+	return request, response, nil
+}
+
+func newMessage(name protoreflect.FullName) (proto.Message, error) {
+	typ := proto.MessageType(string(name))
+	if typ == nil {
+		return nil, fmt.Errorf("unknown message type %s", name)
+	}
+	msg, ok := reflect.New(typ.Elem()).Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("unknown message type %s", name)
+	}
+	return msg, nil
+}
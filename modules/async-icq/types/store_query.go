@@ -0,0 +1,33 @@
+package types
+
+import "strings"
+
+const (
+	// StoreQueryPathPrefix is the prefix of the raw store queries, e.g. /store/bank/key
+	StoreQueryPathPrefix = "/store/"
+	// storeQueryKeySuffix is the only store query subpath supported: the query of the value of a key
+	storeQueryKeySuffix = "/key"
+)
+
+// StoreQueryPath returns the path of the raw query of a key of the store, e.g. /store/bank/key
+func StoreQueryPath(storeName string) string {
+	return StoreQueryPathPrefix + storeName + storeQueryKeySuffix
+}
+
+// ParseStoreQueryPath returns the store name of a raw store key query path. The data of such a query is the key.
+func ParseStoreQueryPath(path string) (storeName string, ok bool) {
+	if !strings.HasPrefix(path, StoreQueryPathPrefix) || !strings.HasSuffix(path, storeQueryKeySuffix) {
+		return "", false
+	}
+	storeName = strings.TrimSuffix(strings.TrimPrefix(path, StoreQueryPathPrefix), storeQueryKeySuffix)
+	if storeName == "" || strings.Contains(storeName, "/") {
+		return "", false
+	}
+	return storeName, true
+}
+
+// IsStoreQueryPath returns true if the path is a raw store key query path
+func IsStoreQueryPath(path string) bool {
+	_, ok := ParseStoreQueryPath(path)
+	return ok
+}
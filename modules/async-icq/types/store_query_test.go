@@ -0,0 +1,20 @@
+package types_test
+
+import "github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+func (suite *TypesTestSuite) TestParseStoreQueryPath() {
+	storeName, ok := types.ParseStoreQueryPath(types.StoreQueryPath("bank"))
+	suite.Require().True(ok)
+	suite.Require().Equal("bank", storeName)
+
+	for _, path := range []string{
+		"/store//key",
+		"/store/bank/subspace",
+		"/store/bank/sub/key",
+		"store/bank/key",
+		"/cosmos.bank.v1beta1.Query/AllBalances",
+	} {
+		_, ok := types.ParseStoreQueryPath(path)
+		suite.Require().False(ok, path)
+	}
+}
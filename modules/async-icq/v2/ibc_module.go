@@ -0,0 +1,113 @@
+package v2
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+)
+
+var _ api.IBCModule = (*IBCModule)(nil)
+
+// IBCModule implements the IBC v2 application interface for interchain query host chains. Queries are received on
+// the host port of a client, without a channel handshake, and served as those received on a channel.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the associated keeper
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface. The module only hosts queries over IBC v2.
+func (IBCModule) OnSendPacket(
+	_ sdk.Context,
+	_ string,
+	_ string,
+	_ uint64,
+	_ channeltypesv2.Payload,
+	_ sdk.AccAddress,
+) error {
+	return errors.Wrap(types.ErrInvalidChannelFlow, "cannot send IBC v2 packet from the interchain query host")
+}
+
+// OnRecvPacket implements the IBCModule interface. The queries of the payload are executed with the allowlist of
+// the destination client, and the response is returned in the acknowledgement of the payload.
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	_ string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	_ sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	txResponse, err := im.recvPayload(ctx, destinationClient, sequence, payload)
+	if err != nil {
+		// Emit an event including the error msg
+		keeper.EmitWriteClientErrorAcknowledgementEvent(ctx, destinationClient, err)
+
+		return channeltypesv2.RecvPacketResult{
+			Status:          channeltypesv2.PacketStatus_Failure,
+			Acknowledgement: channeltypes.NewErrorAcknowledgement(err).Acknowledgement(),
+		}
+	}
+
+	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
+	return channeltypesv2.RecvPacketResult{
+		Status:          channeltypesv2.PacketStatus_Success,
+		Acknowledgement: channeltypes.NewResultAcknowledgement(txResponse).Acknowledgement(),
+	}
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (IBCModule) OnAcknowledgementPacket(
+	_ sdk.Context,
+	_ string,
+	_ string,
+	_ uint64,
+	_ []byte,
+	_ channeltypesv2.Payload,
+	_ sdk.AccAddress,
+) error {
+	return errors.Wrap(types.ErrInvalidChannelFlow, "cannot receive acknowledgement on the interchain query host, which does not send IBC v2 packets")
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (IBCModule) OnTimeoutPacket(
+	_ sdk.Context,
+	_ string,
+	_ string,
+	_ uint64,
+	_ channeltypesv2.Payload,
+	_ sdk.AccAddress,
+) error {
+	return errors.Wrap(types.ErrInvalidChannelFlow, "cannot cause a packet timeout on the interchain query host, which does not send IBC v2 packets")
+}
+
+// recvPayload checks that the payload is an interchain query sent to the host port and executes its queries
+func (im IBCModule) recvPayload(ctx sdk.Context, clientID string, sequence uint64, payload channeltypesv2.Payload) ([]byte, error) {
+	if payload.DestinationPort != im.keeper.GetPort(ctx) {
+		return nil, errors.Wrapf(types.ErrInvalidHostPort, "expected %s, got %s", im.keeper.GetPort(ctx), payload.DestinationPort)
+	}
+	if payload.Version != types.Version {
+		return nil, errors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", payload.Version, types.Version)
+	}
+	if payload.Encoding != types.EncodingJSON {
+		return nil, errors.Wrapf(types.ErrUnknownDataType, "got encoding %s, expected %s", payload.Encoding, types.EncodingJSON)
+	}
+
+	if !im.keeper.IsHostEnabled(ctx) {
+		return nil, types.ErrHostDisabled
+	}
+
+	return im.keeper.OnRecvClientPacket(ctx, clientID, sequence, payload.Value)
+}
@@ -0,0 +1,246 @@
+package v2_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+	v2 "github.com/cosmos/ibc-apps/modules/async-icq/v10/v2"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	hostv2 "github.com/cosmos/ibc-go/v10/modules/core/24-host/v2"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	ibcmockv2 "github.com/cosmos/ibc-go/v10/testing/mock/v2"
+)
+
+const allBalances = "/cosmos.bank.v1beta1.Query/AllBalances"
+
+type InterchainQueriesV2TestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	// path between the clients of the chains, without connection nor channel
+	path *ibctesting.Path
+}
+
+func (suite *InterchainQueriesV2TestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = simapp.SetupTestingApp
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.SetupV2()
+
+	params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.Query/*"})
+	suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+}
+
+func TestInterchainQueriesV2TestSuite(t *testing.T) {
+	suite.Run(t, new(InterchainQueriesV2TestSuite))
+}
+
+// newPayload returns the payload of a query of the balances of the sender of chainB, sent from the mock module of
+// chainA to the host
+func (suite *InterchainQueriesV2TestSuite) newPayload() channeltypesv2.Payload {
+	q := banktypes.QueryAllBalancesRequest{Address: suite.chainB.SenderAccount.GetAddress().String()}
+	data, err := types.SerializeCosmosQuery([]abcitypes.RequestQuery{{
+		Path: allBalances,
+		Data: simapp.GetSimApp(suite.chainB).AppCodec().MustMarshal(&q),
+	}})
+	suite.Require().NoError(err)
+
+	return channeltypesv2.NewPayload(
+		ibcmockv2.PortIDA, types.PortID, types.Version, types.EncodingJSON,
+		types.InterchainQueryPacketData{Data: data}.GetBytes(),
+	)
+}
+
+// relay sends the payload from chainA and receives it on chainB, returning the acknowledgement written by chainB
+// and the events of the receive
+func (suite *InterchainQueriesV2TestSuite) relay(payload channeltypesv2.Payload) (channeltypesv2.Acknowledgement, []abcitypes.Event) {
+	timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).Unix())
+	packet, err := suite.path.EndpointA.MsgSendPacket(timeoutTimestamp, payload)
+	suite.Require().NoError(err)
+
+	proof, proofHeight := suite.path.EndpointA.QueryProof(hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence))
+	res, err := suite.chainB.SendMsgs(channeltypesv2.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+
+	for _, event := range res.Events {
+		if event.Type != channeltypesv2.EventTypeWriteAck {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != channeltypesv2.AttributeKeyEncodedAckHex {
+				continue
+			}
+			bz, err := hex.DecodeString(attr.Value)
+			suite.Require().NoError(err)
+			var ack channeltypesv2.Acknowledgement
+			suite.Require().NoError(proto.Unmarshal(bz, &ack))
+			return ack, res.Events
+		}
+	}
+	suite.FailNow("no acknowledgement written")
+	return channeltypesv2.Acknowledgement{}, nil
+}
+
+func (suite *InterchainQueriesV2TestSuite) TestRelayQuery() {
+	suite.Run("the queries are answered in the acknowledgement", func() {
+		suite.SetupTest()
+
+		ack, events := suite.relay(suite.newPayload())
+		suite.Require().Len(ack.AppAcknowledgements, 1)
+
+		var appAck channeltypes.Acknowledgement
+		suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ack.AppAcknowledgements[0], &appAck))
+		suite.Require().True(appAck.Success())
+		var icqAck types.InterchainQueryPacketAck
+		suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(appAck.GetResult(), &icqAck))
+		resps, err := types.DeserializeCosmosResponse(icqAck.Data)
+		suite.Require().NoError(err)
+		suite.Require().Len(resps, 1)
+
+		var balances banktypes.QueryAllBalancesResponse
+		suite.Require().NoError(simapp.GetSimApp(suite.chainB).AppCodec().Unmarshal(resps[0].Value, &balances))
+		suite.Require().Equal(
+			simapp.GetSimApp(suite.chainB).BankKeeper.GetAllBalances(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress()),
+			balances.Balances,
+		)
+
+		// the execution is reported with the host client
+		var executed *types.EventQueryExecuted
+		for _, e := range events {
+			msg, err := sdk.ParseTypedEvent(e)
+			if err != nil {
+				continue
+			}
+			if event, ok := msg.(*types.EventQueryExecuted); ok {
+				executed = event
+			}
+		}
+		suite.Require().NotNil(executed)
+		suite.Require().Equal(suite.path.EndpointB.ClientID, executed.ClientId)
+		suite.Require().Empty(executed.ChannelId)
+		suite.Require().Equal([]string{allBalances}, executed.Paths)
+	})
+
+	suite.Run("the allowlist of the client applies to the queries", func() {
+		suite.SetupTest()
+
+		override := types.NewClientAllowlistOverride(suite.path.EndpointB.ClientID, []string{"/cosmos.staking.v1beta1.Query/*"})
+		suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetAllowlistOverride(suite.chainB.GetContext(), override))
+
+		ack, _ := suite.relay(suite.newPayload())
+		suite.Require().Equal([][]byte{channeltypesv2.ErrorAcknowledgement[:]}, ack.AppAcknowledgements)
+	})
+}
+
+func (suite *InterchainQueriesV2TestSuite) TestOnRecvPacket() {
+	var payload channeltypesv2.Payload
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+		expErr   error
+	}{
+		{
+			"success", func() {}, true, nil,
+		},
+		{
+			"host disabled", func() {
+				icqKeeper := simapp.GetSimApp(suite.chainB).ICQKeeper
+				params := icqKeeper.GetParams(suite.chainB.GetContext())
+				params.HostEnabled = false
+				suite.Require().NoError(icqKeeper.SetParams(suite.chainB.GetContext(), params))
+			}, false, types.ErrHostDisabled,
+		},
+		{
+			"query not allowed", func() {
+				icqKeeper := simapp.GetSimApp(suite.chainB).ICQKeeper
+				suite.Require().NoError(icqKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, nil)))
+			}, false, nil,
+		},
+		{
+			"invalid destination port", func() {
+				payload.DestinationPort = types.ControllerPortID
+			}, false, types.ErrInvalidHostPort,
+		},
+		{
+			"invalid version", func() {
+				payload.Version = "icq-2"
+			}, false, types.ErrInvalidVersion,
+		},
+		{
+			"invalid encoding", func() {
+				payload.Encoding = "application/x-protobuf"
+			}, false, types.ErrUnknownDataType,
+		},
+		{
+			"invalid packet data", func() {
+				payload.Value = []byte("invalid packet data")
+			}, false, types.ErrUnknownDataType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			payload = suite.newPayload()
+
+			tc.malleate()
+
+			module := v2.NewIBCModule(simapp.GetSimApp(suite.chainB).ICQKeeper)
+			ctx := suite.chainB.GetContext()
+			res := module.OnRecvPacket(ctx, suite.path.EndpointA.ClientID, suite.path.EndpointB.ClientID, 1, payload, suite.chainB.SenderAccount.GetAddress())
+
+			var ack channeltypes.Acknowledgement
+			suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(res.Acknowledgement, &ack))
+			if tc.expPass {
+				suite.Require().Equal(channeltypesv2.PacketStatus_Success, res.Status)
+				suite.Require().True(ack.Success())
+				return
+			}
+
+			suite.Require().Equal(channeltypesv2.PacketStatus_Failure, res.Status)
+			suite.Require().False(ack.Success())
+			if tc.expErr != nil {
+				suite.Require().Equal(channeltypes.NewErrorAcknowledgement(tc.expErr).Acknowledgement(), res.Acknowledgement)
+			}
+		})
+	}
+}
+
+func (suite *InterchainQueriesV2TestSuite) TestHostOnly() {
+	suite.SetupTest()
+
+	module := v2.NewIBCModule(simapp.GetSimApp(suite.chainB).ICQKeeper)
+	ctx := suite.chainB.GetContext()
+	clientA, clientB := suite.path.EndpointA.ClientID, suite.path.EndpointB.ClientID
+	signer := suite.chainB.SenderAccount.GetAddress()
+	payload := suite.newPayload()
+
+	suite.Require().ErrorIs(module.OnSendPacket(ctx, clientB, clientA, 1, payload, signer), types.ErrInvalidChannelFlow)
+	suite.Require().ErrorIs(module.OnAcknowledgementPacket(ctx, clientB, clientA, 1, []byte("ack"), payload, signer), types.ErrInvalidChannelFlow)
+	suite.Require().ErrorIs(module.OnTimeoutPacket(ctx, clientB, clientA, 1, payload, signer), types.ErrInvalidChannelFlow)
+}
@@ -0,0 +1,110 @@
+package ibc_hooks
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+)
+
+// forwardErrorPrefix is the prefix of the error acknowledgements written by the packet forward middleware of
+// ibc-apps. The packet forward middleware of ibc-go doesn't prefix its errors.
+const forwardErrorPrefix = "packet-forward-middleware error:"
+
+var (
+	// abciCodeRegexp matches the error of channeltypes.NewErrorAcknowledgement
+	abciCodeRegexp = regexp.MustCompile(`ABCI code: (\d+)`)
+	// abciErrorRegexp matches the error of channeltypes.NewErrorAcknowledgementWithCodespace
+	abciErrorRegexp = regexp.MustCompile(`ABCI error: ([^/\s]+)/(\d+)`)
+)
+
+// AckFailure describes an error acknowledgement. It is included in the ibc_ack variant of the ibc_lifecycle_complete
+// sudo message when the packet failed.
+type AckFailure struct {
+	// Error is the error of the acknowledgement
+	Error string `json:"error"`
+	// Code is the ABCI code of the error, if the acknowledgement contains it
+	Code *uint32 `json:"code,omitempty"`
+	// Codespace is the codespace of the error, if the acknowledgement contains it
+	Codespace string `json:"codespace,omitempty"`
+	// ForwardError is true if the error has the prefix of the packet forward middleware of ibc-apps, which was
+	// written by an intermediate chain. The packet forward middleware of ibc-go doesn't prefix its errors, so false
+	// doesn't mean that the error was written by the final destination.
+	ForwardError bool `json:"forward_error"`
+	// Hops is the number of forwards in the memo of the packet
+	Hops uint32 `json:"hops"`
+	// Hop is the index of the failing chain in the route of the packet: 0 is the receiver of the packet and i is the
+	// chain reached by the i-th forward. It is only set when the packet isn't forwarded, as the error acknowledgements
+	// of the intermediate chains and of the final destination can't be told apart.
+	Hop *uint32 `json:"hop,omitempty"`
+	// Channel is the channel the failing chain received the packet from, on the chain sending it. For the first hop
+	// it is the source channel of the packet.
+	Channel string `json:"channel,omitempty"`
+}
+
+// ParseAckFailure returns the failure of an error acknowledgement, or nil if the acknowledgement isn't an error.
+// The memo of the packet is used to find the route of the packet through the packet forward middleware.
+//
+// The packet forward middleware relays the error acknowledgement of the final destination unchanged, and the errors
+// of the intermediate chains are written as regular error acknowledgements by ibc-go's packet forward middleware. The
+// failing hop is therefore only known when the packet isn't forwarded.
+func ParseAckFailure(acknowledgement []byte, sourceChannel, memo string) *AckFailure {
+	var ackErr channeltypes.Acknowledgement_Error
+	if err := json.Unmarshal(acknowledgement, &ackErr); err != nil || len(ackErr.Error) == 0 {
+		return nil
+	}
+
+	failure := &AckFailure{
+		Error:        ackErr.Error,
+		ForwardError: strings.HasPrefix(ackErr.Error, forwardErrorPrefix),
+	}
+	if match := abciErrorRegexp.FindStringSubmatch(ackErr.Error); match != nil {
+		failure.Codespace = match[1]
+		failure.Code = parseCode(match[2])
+	} else if match := abciCodeRegexp.FindStringSubmatch(ackErr.Error); match != nil {
+		failure.Code = parseCode(match[1])
+	}
+
+	failure.Hops = uint32(len(forwardRoute(memo)))
+	if failure.Hops == 0 {
+		hop := uint32(0)
+		failure.Hop = &hop
+		failure.Channel = sourceChannel
+	}
+	return failure
+}
+
+func parseCode(s string) *uint32 {
+	code, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return nil
+	}
+	c := uint32(code)
+	return &c
+}
+
+// forwardRoute returns the channels of the forwards in the memo, in order. The next memo of a forward can be either
+// a JSON object or a string containing it.
+func forwardRoute(memo string) []string {
+	var route []string
+	for {
+		var forward *struct {
+			Channel string          `json:"channel"`
+			Next    json.RawMessage `json:"next"`
+		}
+		if _, err := parseMemo(memo).Section("forward", &forward); err != nil || forward == nil {
+			return route
+		}
+		route = append(route, forward.Channel)
+
+		next := forward.Next
+		var nextString string
+		if err := json.Unmarshal(next, &nextString); err == nil {
+			memo = nextString
+		} else {
+			memo = string(next)
+		}
+	}
+}
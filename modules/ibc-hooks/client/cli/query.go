@@ -6,6 +6,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -13,6 +14,8 @@ import (
 	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
 )
 
+const FlagPrefix = "prefix"
+
 func indexRunCmd(cmd *cobra.Command, args []string) error {
 	usageTemplate := `Usage:{{if .HasAvailableSubCommands}}
   {{.CommandPath}} [command]{{end}}
@@ -39,6 +42,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd.Short = fmt.Sprintf("Querying commands for the %s module", types.ModuleName)
 	cmd.AddCommand(
 		GetCmdWasmSender(),
+		GetCmdIntermediateSenderOrigin(),
+		GetCmdStrandedFunds(),
+		GetCmdPacketCallbacks(),
 	)
 	return cmd
 }
@@ -52,16 +58,22 @@ func GetCmdWasmSender() *cobra.Command {
 			fmt.Sprintf(`Generate the local address for a wasm hooks sender.
 Example:
 $ %s query ibc-hooks wasm-hooks-sender channel-42 juno12smx2wdlyttvyzvzg54y2vnqwq2qjatezqwqxu
+$ %s query ibc-hooks wasm-hooks-sender channel-42 juno12smx2wdlyttvyzvzg54y2vnqwq2qjatezqwqxu --prefix osmo
 `,
-				version.AppName,
+				version.AppName, version.AppName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			channelID := args[0]
 			originalSender := args[1]
-			// ToDo: Make this flexible as an arg
-			prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+			prefix, err := cmd.Flags().GetString(FlagPrefix)
+			if err != nil {
+				return err
+			}
+			if prefix == "" {
+				prefix = sdk.GetConfig().GetBech32AccountAddrPrefix()
+			}
 			senderBech32, err := keeper.DeriveIntermediateSender(channelID, originalSender, prefix)
 			if err != nil {
 				return err
@@ -71,7 +83,125 @@ $ %s query ibc-hooks wasm-hooks-sender channel-42 juno12smx2wdlyttvyzvzg54y2vnqw
 		},
 	}
 
+	cmd.Flags().String(FlagPrefix, "", "Bech32 prefix of the derived address (defaults to the chain's account prefix)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdIntermediateSenderOrigin returns the command to look up the channel and original sender of an
+// intermediate sender.
+func GetCmdIntermediateSenderOrigin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wasm-sender-origin <address>",
+		Short: "Look up the channel and original sender an intermediate sender was derived from",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Look up the channel and original sender an intermediate sender was derived from.
+Only intermediate senders that have executed a hook are known.
+Example:
+$ %s query ibchooks wasm-sender-origin cosmos1...
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.IntermediateSenderOrigin(cmd.Context(), &types.QueryIntermediateSenderOriginRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdStrandedFunds returns the command to list the funds kept by intermediate senders after failed executions.
+func GetCmdStrandedFunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stranded-funds",
+		Short: "List the intermediate senders holding funds of failed contract executions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`List the intermediate senders holding funds of failed contract executions sent with wasm.on_failure "keep".
+Example:
+$ %s query ibchooks stranded-funds
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.StrandedFunds(cmd.Context(), &types.QueryStrandedFundsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "stranded-funds")
+
+	return cmd
+}
+
+// GetCmdPacketCallbacks returns the command to list the packet callbacks registered for a contract.
+func GetCmdPacketCallbacks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-callbacks <contract>",
+		Short: "List the packet callbacks registered for a contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`List the packets in flight whose ack or timeout will be sent to the contract.
+Example:
+$ %s query ibchooks packet-callbacks cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PacketCallbacks(cmd.Context(), &types.QueryPacketCallbacksRequest{
+				Contract:   args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packet-callbacks")
 
 	return cmd
 }
@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+)
+
+const (
+	// ExpiryReasonTimeout is the reason of a callback expired long after the timeout of its packet
+	ExpiryReasonTimeout = "timeout"
+	// ExpiryReasonChannelClosed is the reason of a callback whose channel has been closed
+	ExpiryReasonChannelClosed = "channel_closed"
+)
+
+// ExpiredSudoMsg is the sudo message sent to a contract when its packet callback expires
+type ExpiredSudoMsg struct {
+	IBCLifecycleComplete struct {
+		Expired IBCExpired `json:"expired"`
+	} `json:"ibc_lifecycle_complete"`
+}
+
+type IBCExpired struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+	Reason   string `json:"reason"`
+}
+
+type expiredCallback struct {
+	record types.PacketCallbackRecord
+	reason string
+}
+
+// EndBlocker prunes the packet callbacks that will never receive an acknowledgement or a timeout: the callbacks of
+// packets past their timeout by more than the callback expiry delay, including the packets of closed channels. At
+// most MaxCallbacksCheckedPerBlock callbacks are checked per block, resuming after the last callback checked by the
+// previous block. The contracts are notified of their expired callbacks with the ExpiredCallbackGasLimit.
+func (k Keeper) EndBlocker(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if params.MaxCallbacksCheckedPerBlock == 0 {
+		return nil
+	}
+
+	expired, err := k.findExpiredCallbacks(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	for _, callback := range expired {
+		if err := k.notifyExpiredCallback(ctx, callback, params.ExpiredCallbackGasLimit); err != nil {
+			k.Logger(ctx).Debug("expired callback notification failed", "contract", callback.record.Contract, "error", err)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					"ibc-expired-callback-error",
+					sdk.NewAttribute("contract", callback.record.Contract),
+					sdk.NewAttribute("channel", callback.record.ChannelId),
+					sdk.NewAttribute("sequence", fmt.Sprintf("%d", callback.record.Sequence)),
+					sdk.NewAttribute("error", err.Error()),
+				),
+			)
+		}
+		if err := k.DeletePacketCallback(ctx, callback.record.ChannelId, callback.record.Sequence); err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"ibc-hooks-callback-expired",
+				sdk.NewAttribute("contract", callback.record.Contract),
+				sdk.NewAttribute("channel", callback.record.ChannelId),
+				sdk.NewAttribute("sequence", fmt.Sprintf("%d", callback.record.Sequence)),
+				sdk.NewAttribute("reason", callback.reason),
+			),
+		)
+	}
+	return nil
+}
+
+// findExpiredCallbacks checks the callbacks after the expiry cursor, wrapping around to the first callback, and
+// moves the cursor to the last callback checked
+func (k Keeper) findExpiredCallbacks(ctx sdk.Context, params types.Params) ([]expiredCallback, error) {
+	budget := int(params.MaxCallbacksCheckedPerBlock)
+	cursor, err := k.CallbackExpiryCursor.Get(ctx)
+	hasCursor := err == nil
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	var (
+		expired []expiredCallback
+		updated []types.PacketCallbackRecord
+		last    collections.Pair[string, uint64]
+		checked int
+	)
+	check := func(key collections.Pair[string, uint64], record types.PacketCallbackRecord) (bool, error) {
+		checkedRecord, reason, ok := k.callbackExpiry(ctx, record, params.CallbackExpiryDelay)
+		switch {
+		case ok:
+			expired = append(expired, expiredCallback{record: checkedRecord, reason: reason})
+		case checkedRecord.TimeoutTimestamp != record.TimeoutTimestamp:
+			updated = append(updated, checkedRecord)
+		}
+		last = key
+		checked++
+		return checked >= budget, nil
+	}
+
+	var ranger collections.Ranger[collections.Pair[string, uint64]]
+	if hasCursor {
+		ranger = new(collections.Range[collections.Pair[string, uint64]]).StartExclusive(cursor)
+	}
+	if err := k.Callbacks.Walk(ctx, ranger, check); err != nil {
+		return nil, err
+	}
+	if hasCursor && checked < budget {
+		ranger = new(collections.Range[collections.Pair[string, uint64]]).EndInclusive(cursor)
+		if err := k.Callbacks.Walk(ctx, ranger, check); err != nil {
+			return nil, err
+		}
+	}
+
+	// the callbacks can't be written while they are walked
+	for _, record := range updated {
+		if err := k.Callbacks.Set(ctx, collections.Join(record.ChannelId, record.Sequence), record); err != nil {
+			return nil, err
+		}
+	}
+
+	if checked == 0 {
+		return expired, k.CallbackExpiryCursor.Remove(ctx)
+	}
+	return expired, k.CallbackExpiryCursor.Set(ctx, last)
+}
+
+// callbackExpiry returns the reason a callback has expired, if it has. A callback expires once the expiry delay has
+// passed since the timeout of its packet, as the packet can be timed out until then, even on a closed channel. The
+// time a timeout height is reached isn't known, so the first block seeing the counterparty client reach it records
+// its time as the timeout timestamp of the callback. The first block seeing the channel of a callback without a known
+// timeout closed does the same, as the packet can be timed out on close from then on. The returned record has the
+// timeout timestamp recorded by this block, if any.
+func (k Keeper) callbackExpiry(ctx sdk.Context, record types.PacketCallbackRecord, delay time.Duration) (types.PacketCallbackRecord, string, bool) {
+	now := uint64(ctx.BlockTime().UnixNano())
+	closed := k.isChannelClosed(ctx, record)
+	switch {
+	case record.TimeoutRevisionHeight != 0:
+		if (record.TimeoutTimestamp == 0 || record.TimeoutTimestamp > now) && k.isTimeoutHeightReached(ctx, record) {
+			record.TimeoutTimestamp = now
+		}
+	case record.TimeoutTimestamp == 0 && closed:
+		record.TimeoutTimestamp = now
+	}
+
+	if record.TimeoutTimestamp == 0 || record.TimeoutTimestamp > math.MaxInt64 {
+		return record, "", false
+	}
+	expiry := time.Unix(0, int64(record.TimeoutTimestamp)).Add(delay)
+	if !ctx.BlockTime().After(expiry) {
+		return record, "", false
+	}
+	if closed {
+		return record, ExpiryReasonChannelClosed, true
+	}
+	return record, ExpiryReasonTimeout, true
+}
+
+// isChannelClosed returns true if the channel of the callback is closed
+func (k Keeper) isChannelClosed(ctx sdk.Context, record types.PacketCallbackRecord) bool {
+	channel, found := k.channelKeeper.GetChannel(ctx, record.PortId, record.ChannelId)
+	return found && channel.State == channeltypes.CLOSED
+}
+
+// isTimeoutHeightReached returns true if the client of the channel of the callback has reached the timeout height of
+// the packet on the counterparty chain
+func (k Keeper) isTimeoutHeightReached(ctx sdk.Context, record types.PacketCallbackRecord) bool {
+	if k.clientKeeper == nil {
+		return false
+	}
+	_, connection, err := k.channelKeeper.GetChannelConnection(ctx, record.PortId, record.ChannelId)
+	if err != nil {
+		return false
+	}
+	timeoutHeight := clienttypes.NewHeight(record.TimeoutRevisionNumber, record.TimeoutRevisionHeight)
+	return k.clientKeeper.GetClientLatestHeight(ctx, connection.ClientId).GTE(timeoutHeight)
+}
+
+// notifyExpiredCallback sends the expired variant of the ibc_lifecycle_complete sudo message to the contract of the
+// callback with its own gas meter
+func (k Keeper) notifyExpiredCallback(ctx sdk.Context, callback expiredCallback, gasLimit uint64) error {
+	if k.contractKeeper == nil {
+		return fmt.Errorf("no contract keeper")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(callback.record.Contract)
+	if err != nil {
+		return err
+	}
+
+	var msg ExpiredSudoMsg
+	msg.IBCLifecycleComplete.Expired = IBCExpired{
+		Channel:  callback.record.ChannelId,
+		Sequence: callback.record.Sequence,
+		Reason:   callback.reason,
+	}
+	sudoMsg, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return ExecuteWithGasLimit(ctx, gasLimit, "ibc-hooks expired callback", func(cacheCtx sdk.Context) error {
+		_, err := k.contractKeeper.Sudo(cacheCtx, contractAddr, sudoMsg)
+		return err
+	})
+}
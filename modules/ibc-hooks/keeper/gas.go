@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExecuteWithGasLimit runs fn in a cached context with its own gas meter. The state changes of fn are only written if
+// it succeeds, panics (including running out of gas) are returned as errors, and the gas used by fn is always
+// charged to the parent context.
+func ExecuteWithGasLimit(ctx sdk.Context, gasLimit uint64, descriptor string, fn func(sdk.Context) error) (err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = fmt.Errorf("out of gas: %s", oog.Descriptor)
+			} else {
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), descriptor)
+	}()
+
+	if err = fn(cacheCtx); err != nil {
+		return err
+	}
+	writeCache()
+	return nil
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+)
+
+// InitGenesis initializes the ibc-hooks state
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	if err := k.SetParams(ctx, state.Params); err != nil {
+		panic(fmt.Sprintf("could not set params: %v", err))
+	}
+	// the callbacks keep the block they were registered at
+	for _, record := range state.Callbacks {
+		if err := k.Callbacks.Set(ctx, collections.Join(record.ChannelId, record.Sequence), record); err != nil {
+			panic(fmt.Sprintf("could not set packet callback: %v", err))
+		}
+	}
+	for _, pendingAck := range state.PendingAcknowledgements {
+		var packet channeltypes.Packet
+		channeltypes.SubModuleCdc.MustUnmarshal(pendingAck.Packet, &packet)
+		k.StorePendingAcknowledgement(ctx, packet, pendingAck.Contract)
+	}
+	for _, authorization := range state.CallbackAuthorizations {
+		k.SetCallbackAuthorization(ctx, authorization.Contract, authorization.Sender, true)
+	}
+	for _, strandedFunds := range state.StrandedFunds {
+		k.SetStrandedFunds(ctx, strandedFunds)
+	}
+	for _, observer := range state.Observers {
+		if err := k.AddObserver(ctx, observer.ChannelId, observer.Denom, observer.Contract); err != nil {
+			panic(fmt.Sprintf("could not add observer: %v", err))
+		}
+	}
+	for _, intermediateSender := range state.IntermediateSenders {
+		if err := k.RecordIntermediateSender(ctx, intermediateSender.Address, intermediateSender.Origin.ChannelId, intermediateSender.Origin.OriginalSender); err != nil {
+			panic(fmt.Sprintf("could not record intermediate sender: %v", err))
+		}
+	}
+}
+
+// ExportGenesis exports the ibc-hooks genesis state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	callbacks, err := k.GetAllPacketCallbacks(ctx)
+	if err != nil {
+		panic(fmt.Sprintf("could not export packet callbacks: %v", err))
+	}
+	return &types.GenesisState{
+		Params:                  k.GetParams(ctx),
+		Callbacks:               callbacks,
+		PendingAcknowledgements: k.GetAllPendingAcknowledgements(ctx),
+		CallbackAuthorizations:  k.GetAllCallbackAuthorizations(ctx),
+		StrandedFunds:           k.GetAllStrandedFunds(ctx),
+		Observers:               k.GetAllObservers(ctx),
+		IntermediateSenders:     k.GetAllIntermediateSenders(ctx),
+	}
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{
+		Params: k.GetParams(ctx),
+	}, nil
+}
+
+// StrandedFunds implements the Query/StrandedFunds gRPC method
+func (k Keeper) StrandedFunds(c context.Context, req *types.QueryStrandedFundsRequest) (*types.QueryStrandedFundsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), GetStrandedFundsPrefix())
+
+	var strandedBalances []types.StrandedBalance
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var strandedFunds types.StrandedFunds
+		if err := types.ModuleCdc.Unmarshal(value, &strandedFunds); err != nil {
+			return err
+		}
+
+		balance := sdk.NewCoins()
+		if k.bankKeeper != nil {
+			addr, err := sdk.AccAddressFromBech32(strandedFunds.Address)
+			if err != nil {
+				return err
+			}
+			balance = k.bankKeeper.GetAllBalances(ctx, addr)
+		}
+
+		strandedBalances = append(strandedBalances, types.StrandedBalance{
+			StrandedFunds: strandedFunds,
+			Balance:       balance,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStrandedFundsResponse{
+		StrandedBalances: strandedBalances,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// IntermediateSender implements the Query/IntermediateSender gRPC method
+func (k Keeper) IntermediateSender(_ context.Context, req *types.QueryIntermediateSenderRequest) (*types.QueryIntermediateSenderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.ChannelId == "" || req.OriginalSender == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel id and original sender are required")
+	}
+
+	prefix := req.Prefix
+	if prefix == "" {
+		prefix = sdk.GetConfig().GetBech32AccountAddrPrefix()
+	}
+	address, err := DeriveIntermediateSender(req.ChannelId, req.OriginalSender, prefix)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryIntermediateSenderResponse{Address: address}, nil
+}
+
+// IntermediateSenderOrigin implements the Query/IntermediateSenderOrigin gRPC method
+func (k Keeper) IntermediateSenderOrigin(c context.Context, req *types.QueryIntermediateSenderOriginRequest) (*types.QueryIntermediateSenderOriginResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	origin, found := k.GetIntermediateSenderOrigin(ctx, req.Address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no origin recorded for %s", req.Address)
+	}
+
+	return &types.QueryIntermediateSenderOriginResponse{Origin: origin}, nil
+}
+
+// PacketCallbacks implements the Query/PacketCallbacks gRPC method
+func (k Keeper) PacketCallbacks(c context.Context, req *types.QueryPacketCallbacksRequest) (*types.QueryPacketCallbacksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Contract); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	callbacks, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.Callbacks.Indexes.Contract,
+		req.Pagination,
+		func(key collections.Pair[string, collections.Pair[string, uint64]], _ collections.NoValue) (types.PacketCallbackRecord, error) {
+			return k.Callbacks.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, collections.Pair[string, uint64]](req.Contract),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPacketCallbacksResponse{
+		Callbacks:  callbacks,
+		Pagination: pageRes,
+	}, nil
+}
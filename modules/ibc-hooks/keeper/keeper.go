@@ -1,29 +1,122 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
 	logv2 "cosmossdk.io/log/v2"
-	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v11/modules/core/05-port/types"
 )
 
 type (
 	Keeper struct {
-		storeKey storetypes.StoreKey
+		storeKey      storetypes.StoreKey
+		channelKeeper types.ChannelKeeper
+		clientKeeper  types.ClientKeeper
+		bankKeeper    types.BankKeeper
+		ics4Wrapper   porttypes.ICS4Wrapper
+		// contractKeeper notifies the contracts of expired callbacks
+		contractKeeper types.ContractKeeper
+
+		// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+		authority string
+
+		Schema collections.Schema
+		// Callbacks are the callbacks of the packets in flight, keyed by source channel and sequence
+		Callbacks *collections.IndexedMap[collections.Pair[string, uint64], types.PacketCallbackRecord, PacketCallbackIndexes]
+		// CallbackExpiryCursor is the key of the last callback checked for expiry
+		CallbackExpiryCursor collections.Item[collections.Pair[string, uint64]]
+	}
+
+	// PacketCallbackIndexes are the secondary indexes of the packet callbacks
+	PacketCallbackIndexes struct {
+		// Contract indexes the callbacks by contract
+		Contract *indexes.Multi[string, collections.Pair[string, uint64], types.PacketCallbackRecord]
 	}
 )
 
+func (i PacketCallbackIndexes) IndexesList() []collections.Index[collections.Pair[string, uint64], types.PacketCallbackRecord] {
+	return []collections.Index[collections.Pair[string, uint64], types.PacketCallbackRecord]{i.Contract}
+}
+
 // NewKeeper returns a new instance of the x/ibchooks keeper
 func NewKeeper(
-	storeKey storetypes.StoreKey,
+	storeKey *storetypes.KVStoreKey,
+	channelKeeper types.ChannelKeeper,
+	clientKeeper types.ClientKeeper,
+	bankKeeper types.BankKeeper,
+	authority string,
 ) Keeper {
-	return Keeper{
-		storeKey: storeKey,
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storeKey))
+	k := Keeper{
+		storeKey:      storeKey,
+		channelKeeper: channelKeeper,
+		clientKeeper:  clientKeeper,
+		bankKeeper:    bankKeeper,
+		authority:     authority,
+		Callbacks: collections.NewIndexedMap(
+			sb,
+			types.PacketCallbackPrefix,
+			"packet_callbacks",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.PacketCallbackRecord](types.ModuleCdc),
+			PacketCallbackIndexes{
+				Contract: indexes.NewMulti(
+					sb,
+					types.PacketCallbackByContractPrefix,
+					"packet_callbacks_by_contract",
+					collections.StringKey,
+					collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+					func(_ collections.Pair[string, uint64], record types.PacketCallbackRecord) (string, error) {
+						return record.Contract, nil
+					},
+				),
+			},
+		),
+		CallbackExpiryCursor: collections.NewItem(
+			sb,
+			types.CallbackExpiryCursorPrefix,
+			"callback_expiry_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		),
 	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// WithICS4Wrapper sets the ICS4 wrapper used to write asynchronous acknowledgements. It is set after the
+// ibc-hooks ICS4Middleware has been created, as the middleware depends on the keeper.
+func (k *Keeper) WithICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper) {
+	k.ics4Wrapper = ics4Wrapper
+}
+
+// WithContractKeeper sets the contract keeper used to notify the contracts of expired callbacks. It is set after
+// the wasm keeper has been created, as the wasm keeper depends on the ibc-hooks ICS4Middleware.
+func (k *Keeper) WithContractKeeper(contractKeeper types.ContractKeeper) {
+	k.contractKeeper = contractKeeper
 }
 
 // Logger returns a logger for the x/ibchooks module
@@ -31,26 +124,248 @@ func (k Keeper) Logger(ctx sdk.Context) logv2.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-func GetPacketKey(channel string, packetSequence uint64) []byte {
-	return []byte(fmt.Sprintf("%s::%d", channel, packetSequence))
+// StorePacketCallback stores which contract will be listening for the ack or timeout of a packet. The block it
+// is registered at is set from the context.
+func (k Keeper) StorePacketCallback(ctx sdk.Context, record types.PacketCallbackRecord) error {
+	record.RegisteredHeight = ctx.BlockHeight()
+	record.RegisteredTime = ctx.BlockTime()
+	return k.Callbacks.Set(ctx, collections.Join(record.ChannelId, record.Sequence), record)
 }
 
-// StorePacketCallback stores which contract will be listening for the ack or timeout of a packet
-func (k Keeper) StorePacketCallback(ctx sdk.Context, channel string, packetSequence uint64, contract string) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(GetPacketKey(channel, packetSequence), []byte(contract))
+// GetPacketCallbackRecord returns the callback registered for a packet, if any
+func (k Keeper) GetPacketCallbackRecord(ctx sdk.Context, channel string, packetSequence uint64) (types.PacketCallbackRecord, bool) {
+	record, err := k.Callbacks.Get(ctx, collections.Join(channel, packetSequence))
+	if err != nil {
+		return types.PacketCallbackRecord{}, false
+	}
+	return record, true
 }
 
 // GetPacketCallback returns the bech32 addr of the contract that is expecting a callback from a packet
 func (k Keeper) GetPacketCallback(ctx sdk.Context, channel string, packetSequence uint64) string {
-	store := ctx.KVStore(k.storeKey)
-	return string(store.Get(GetPacketKey(channel, packetSequence)))
+	record, _ := k.GetPacketCallbackRecord(ctx, channel, packetSequence)
+	return record.Contract
 }
 
 // DeletePacketCallback deletes the callback from storage once it has been processed
-func (k Keeper) DeletePacketCallback(ctx sdk.Context, channel string, packetSequence uint64) {
+func (k Keeper) DeletePacketCallback(ctx sdk.Context, channel string, packetSequence uint64) error {
+	err := k.Callbacks.Remove(ctx, collections.Join(channel, packetSequence))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// GetAllPacketCallbacks returns the callbacks of all the packets in flight
+func (k Keeper) GetAllPacketCallbacks(ctx sdk.Context) ([]types.PacketCallbackRecord, error) {
+	iter, err := k.Callbacks.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// GetPacketCallbacksByContract returns the callbacks registered for the contract
+func (k Keeper) GetPacketCallbacksByContract(ctx sdk.Context, contract string) ([]types.PacketCallbackRecord, error) {
+	iter, err := k.Callbacks.Indexes.Contract.MatchExact(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	return indexes.CollectValues(ctx, k.Callbacks, iter)
+}
+
+func GetCallbackAuthorizationKey(contract, sender string) []byte {
+	return []byte(fmt.Sprintf("%s::%s::%s", types.CallbackAuthorizationPrefix, contract, sender))
+}
+
+// SetCallbackAuthorization allows or disallows the sender to set the contract as the ibc_callback of its packets
+func (k Keeper) SetCallbackAuthorization(ctx sdk.Context, contract, sender string, authorized bool) {
+	store := ctx.KVStore(k.storeKey)
+	if authorized {
+		store.Set(GetCallbackAuthorizationKey(contract, sender), []byte{1})
+	} else {
+		store.Delete(GetCallbackAuthorizationKey(contract, sender))
+	}
+}
+
+// IsCallbackAuthorized returns true if the sender can set the contract as the ibc_callback of its packets.
+// A contract is always allowed to be its own callback.
+func (k Keeper) IsCallbackAuthorized(ctx sdk.Context, contract, sender string) bool {
+	if contract == sender {
+		return true
+	}
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetCallbackAuthorizationKey(contract, sender))
+}
+
+// GetAllCallbackAuthorizations returns the senders allowed to set a contract as the ibc_callback of their packets
+func (k Keeper) GetAllCallbackAuthorizations(ctx sdk.Context) []types.CallbackAuthorization {
+	prefix := []byte(fmt.Sprintf("%s::", types.CallbackAuthorizationPrefix))
+	iterator := ctx.KVStore(k.storeKey).Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	defer iterator.Close()
+
+	var authorizations []types.CallbackAuthorization
+	for ; iterator.Valid(); iterator.Next() {
+		// bech32 addresses can't contain the separator
+		contract, sender, _ := strings.Cut(string(iterator.Key()[len(prefix):]), "::")
+		authorizations = append(authorizations, types.CallbackAuthorization{Contract: contract, Sender: sender})
+	}
+	return authorizations
+}
+
+func GetStrandedFundsPrefix() []byte {
+	return []byte(fmt.Sprintf("%s::", types.StrandedFundsPrefix))
+}
+
+func GetStrandedFundsKey(address string) []byte {
+	return append(GetStrandedFundsPrefix(), []byte(address)...)
+}
+
+// SetStrandedFunds records that the intermediate sender kept the funds of a failed contract execution
+func (k Keeper) SetStrandedFunds(ctx sdk.Context, strandedFunds types.StrandedFunds) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(GetPacketKey(channel, packetSequence))
+	store.Set(GetStrandedFundsKey(strandedFunds.Address), types.ModuleCdc.MustMarshal(&strandedFunds))
+}
+
+// GetStrandedFunds returns the stranded funds record of the intermediate sender, if any
+func (k Keeper) GetStrandedFunds(ctx sdk.Context, address string) (types.StrandedFunds, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetStrandedFundsKey(address))
+	if bz == nil {
+		return types.StrandedFunds{}, false
+	}
+	var strandedFunds types.StrandedFunds
+	types.ModuleCdc.MustUnmarshal(bz, &strandedFunds)
+	return strandedFunds, true
+}
+
+// DeleteStrandedFunds deletes the stranded funds record of the intermediate sender once the funds are recovered
+func (k Keeper) DeleteStrandedFunds(ctx sdk.Context, address string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetStrandedFundsKey(address))
+}
+
+// GetAllStrandedFunds returns the stranded funds records of all the intermediate senders
+func (k Keeper) GetAllStrandedFunds(ctx sdk.Context) []types.StrandedFunds {
+	prefix := GetStrandedFundsPrefix()
+	iterator := ctx.KVStore(k.storeKey).Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	defer iterator.Close()
+
+	var allStrandedFunds []types.StrandedFunds
+	for ; iterator.Valid(); iterator.Next() {
+		var strandedFunds types.StrandedFunds
+		types.ModuleCdc.MustUnmarshal(iterator.Value(), &strandedFunds)
+		allStrandedFunds = append(allStrandedFunds, strandedFunds)
+	}
+	return allStrandedFunds
+}
+
+func GetPendingAckKey(channel string, packetSequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s::%s::%d", types.PendingAckPrefix, channel, packetSequence))
+}
+
+// StorePendingAcknowledgement stores a received packet that the contract will acknowledge asynchronously
+func (k Keeper) StorePendingAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, contract string) {
+	store := ctx.KVStore(k.storeKey)
+	pendingAck := types.PendingAcknowledgement{
+		Contract: contract,
+		Packet:   channeltypes.SubModuleCdc.MustMarshal(&packet),
+	}
+	store.Set(GetPendingAckKey(packet.GetDestChannel(), packet.GetSequence()), types.ModuleCdc.MustMarshal(&pendingAck))
+}
+
+// GetPendingAcknowledgement returns the packet awaiting an asynchronous acknowledgement and the contract allowed
+// to write it
+func (k Keeper) GetPendingAcknowledgement(ctx sdk.Context, channel string, packetSequence uint64) (channeltypes.Packet, string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetPendingAckKey(channel, packetSequence))
+	if bz == nil {
+		return channeltypes.Packet{}, "", false
+	}
+	var pendingAck types.PendingAcknowledgement
+	types.ModuleCdc.MustUnmarshal(bz, &pendingAck)
+	var packet channeltypes.Packet
+	channeltypes.SubModuleCdc.MustUnmarshal(pendingAck.Packet, &packet)
+	return packet, pendingAck.Contract, true
+}
+
+// DeletePendingAcknowledgement deletes the pending acknowledgement once it has been written
+func (k Keeper) DeletePendingAcknowledgement(ctx sdk.Context, channel string, packetSequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetPendingAckKey(channel, packetSequence))
+}
+
+// GetAllPendingAcknowledgements returns the packets awaiting an asynchronous acknowledgement
+func (k Keeper) GetAllPendingAcknowledgements(ctx sdk.Context) []types.PendingAcknowledgement {
+	prefix := []byte(fmt.Sprintf("%s::", types.PendingAckPrefix))
+	iterator := ctx.KVStore(k.storeKey).Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	defer iterator.Close()
+
+	var pendingAcks []types.PendingAcknowledgement
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingAck types.PendingAcknowledgement
+		types.ModuleCdc.MustUnmarshal(iterator.Value(), &pendingAck)
+		pendingAcks = append(pendingAcks, pendingAck)
+	}
+	return pendingAcks
+}
+
+func GetIntermediateSenderKey(address []byte) []byte {
+	return append([]byte(fmt.Sprintf("%s::", types.IntermediateSenderPrefix)), address...)
+}
+
+// RecordIntermediateSender records the origin of an intermediate sender the first time it is used, so it can be
+// looked up from the derived address. The address is keyed by its bytes, so any bech32 prefix can be used.
+func (k Keeper) RecordIntermediateSender(ctx sdk.Context, address, channel, originalSender string) error {
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := GetIntermediateSenderKey(bz)
+	if store.Has(key) {
+		return nil
+	}
+	origin := types.IntermediateSenderOrigin{
+		ChannelId:      channel,
+		OriginalSender: originalSender,
+	}
+	store.Set(key, types.ModuleCdc.MustMarshal(&origin))
+	return nil
+}
+
+// GetIntermediateSenderOrigin returns the channel and original sender the intermediate sender was derived from
+func (k Keeper) GetIntermediateSenderOrigin(ctx sdk.Context, address string) (types.IntermediateSenderOrigin, bool) {
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return types.IntermediateSenderOrigin{}, false
+	}
+	store := ctx.KVStore(k.storeKey)
+	originBz := store.Get(GetIntermediateSenderKey(bz))
+	if originBz == nil {
+		return types.IntermediateSenderOrigin{}, false
+	}
+	var origin types.IntermediateSenderOrigin
+	types.ModuleCdc.MustUnmarshal(originBz, &origin)
+	return origin, true
+}
+
+// GetAllIntermediateSenders returns the origins of all the intermediate senders used so far
+func (k Keeper) GetAllIntermediateSenders(ctx sdk.Context) []types.IntermediateSender {
+	prefix := GetIntermediateSenderKey(nil)
+	iterator := ctx.KVStore(k.storeKey).Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	defer iterator.Close()
+
+	var intermediateSenders []types.IntermediateSender
+	for ; iterator.Valid(); iterator.Next() {
+		var origin types.IntermediateSenderOrigin
+		types.ModuleCdc.MustUnmarshal(iterator.Value(), &origin)
+		intermediateSenders = append(intermediateSenders, types.IntermediateSender{
+			Address: sdk.AccAddress(iterator.Key()[len(prefix):]).String(),
+			Origin:  origin,
+		})
+	}
+	return intermediateSenders
 }
 
 func DeriveIntermediateSender(channel, originalSender, bech32Prefix string) (string, error) {
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v11/modules/core/04-channel/v2/types"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+func NewMigrator(k *Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate1to2 migrates the module state from the consensus version 1 to
+// version 2. Specifically, it sets the default parameters, which didn't exist
+// in version 1: no send guards, the default observer limits and no relayer
+// fees.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.SetParams(ctx, types.DefaultParams())
+}
+
+// Migrate2to3 migrates the module state from the consensus version 2 to
+// version 3. Specifically, it moves the packet callbacks, stored as the bech32
+// address of the contract under the "<channel>::<sequence>" key, to the
+// Callbacks collection. The callbacks of version 2 could only be set on the
+// packets sent from the transfer port, so they are recorded with that port.
+// Their sender is unknown, and they are recorded as registered at the upgrade
+// height. The callbacks of IBC v2 packets, keyed by client, are recorded with
+// the latest timeout core could have accepted for their packets, MaxTimeoutDelta
+// after the upgrade, as they have no channel to be closed.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+
+	type legacyCallback struct {
+		key      []byte
+		channel  string
+		sequence uint64
+		contract string
+	}
+	var callbacks []legacyCallback
+
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		channel, sequence, ok := parseLegacyPacketKey(iterator.Key())
+		if !ok {
+			continue
+		}
+		callbacks = append(callbacks, legacyCallback{
+			key:      iterator.Key(),
+			channel:  channel,
+			sequence: sequence,
+			contract: string(iterator.Value()),
+		})
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, callback := range callbacks {
+		store.Delete(callback.key)
+		record := types.PacketCallbackRecord{
+			Contract:  callback.contract,
+			PortId:    transfertypes.PortID,
+			ChannelId: callback.channel,
+			Sequence:  callback.sequence,
+		}
+		if !channeltypes.IsValidChannelID(callback.channel) {
+			record.TimeoutTimestamp = uint64(ctx.BlockTime().Add(channeltypesv2.MaxTimeoutDelta).UnixNano())
+		}
+		if err := m.keeper.StorePacketCallback(ctx, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate3to4 migrates the module state from the consensus version 3 to
+// version 4. Specifically, it sets the default parameters of the callback
+// expiry, which didn't exist in version 3. The timeouts of the channel
+// callbacks migrated from version 2 are unknown, so they expire the callback
+// expiry delay after their channel is closed.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.CallbackExpiryDelay = types.DefaultCallbackExpiryDelay
+	params.MaxCallbacksCheckedPerBlock = types.DefaultMaxCallbacksCheckedPerBlock
+	params.ExpiredCallbackGasLimit = types.DefaultExpiredCallbackGasLimit
+	return m.keeper.SetParams(ctx, params)
+}
+
+// legacyKeyPrefixes are the prefixes of the keys of the consensus version 2 that aren't packet callbacks
+var legacyKeyPrefixes = []string{
+	types.CallbackAuthorizationPrefix + "::",
+	types.StrandedFundsPrefix + "::",
+	types.PendingAckPrefix + "::",
+	types.IntermediateSenderPrefix + "::",
+	types.ObserverPrefix + "::",
+	types.ParamsKey,
+}
+
+// parseLegacyPacketKey parses a "<channel>::<sequence>" key of the consensus version 2
+func parseLegacyPacketKey(key []byte) (channel string, sequence uint64, ok bool) {
+	for _, prefix := range legacyKeyPrefixes {
+		if bytes.HasPrefix(key, []byte(prefix)) {
+			return "", 0, false
+		}
+	}
+
+	channel, sequenceStr, found := strings.Cut(string(key), "::")
+	if !found || channel == "" || strings.Contains(sequenceStr, ":") {
+		return "", 0, false
+	}
+	sequence, err := strconv.ParseUint(sequenceStr, 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return channel, sequence, true
+}
@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// RegisterPacketCallback registers the sender contract as the ibc_lifecycle_complete callback of a packet
+// that is still in flight. Only packets sent from a port owned by the contract can be registered, so a
+// contract can't subscribe to (and potentially block the acks of) packets sent by someone else.
+func (m msgServer) RegisterPacketCallback(goCtx context.Context, msg *types.MsgRegisterPacketCallback) (*types.MsgRegisterPacketCallbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid sender address")
+	}
+	if !isPortOwner(msg.PortId, sender) {
+		return nil, errors.Wrapf(types.ErrUnauthorizedCallback, "port %s is not owned by %s", msg.PortId, msg.Sender)
+	}
+
+	if m.channelKeeper == nil || !m.channelKeeper.HasPacketCommitment(ctx, msg.PortId, msg.ChannelId, msg.Sequence) {
+		return nil, errors.Wrapf(types.ErrPacketNotFound, "%s/%s/%d", msg.PortId, msg.ChannelId, msg.Sequence)
+	}
+	if m.GetPacketCallback(ctx, msg.ChannelId, msg.Sequence) != "" {
+		return nil, errors.Wrapf(types.ErrCallbackExists, "%s/%d", msg.ChannelId, msg.Sequence)
+	}
+
+	// The timeout of the packet isn't known, so the callback only expires if the channel is closed
+	if err := m.StorePacketCallback(ctx, types.PacketCallbackRecord{
+		Contract:  msg.Sender,
+		PortId:    msg.PortId,
+		ChannelId: msg.ChannelId,
+		Sequence:  msg.Sequence,
+		Sender:    msg.Sender,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterPacketCallbackResponse{}, nil
+}
+
+// UpdateCallbackAuthorization grants or revokes the permission of the sender to set the signer contract as
+// the ibc_callback of the packets it sends.
+func (m msgServer) UpdateCallbackAuthorization(goCtx context.Context, msg *types.MsgUpdateCallbackAuthorization) (*types.MsgUpdateCallbackAuthorizationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return nil, errors.Wrap(err, "invalid contract address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return nil, errors.Wrap(err, "invalid sender address")
+	}
+
+	m.SetCallbackAuthorization(ctx, msg.Contract, msg.Sender, msg.Authorized)
+	return &types.MsgUpdateCallbackAuthorizationResponse{}, nil
+}
+
+// WriteHookAcknowledgement writes the acknowledgement of a packet that the sender contract chose to acknowledge
+// asynchronously. The acknowledgement goes through the ibc-hooks ICS4Middleware.
+func (m msgServer) WriteHookAcknowledgement(goCtx context.Context, msg *types.MsgWriteHookAcknowledgement) (*types.MsgWriteHookAcknowledgementResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if m.ics4Wrapper == nil {
+		return nil, errors.Wrap(types.ErrInvalidAck, "ICS4 wrapper not set")
+	}
+
+	packet, contract, found := m.GetPendingAcknowledgement(ctx, msg.ChannelId, msg.Sequence)
+	if !found {
+		return nil, errors.Wrapf(types.ErrPendingAckNotFound, "%s/%d", msg.ChannelId, msg.Sequence)
+	}
+	if contract != msg.Sender {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only %s can acknowledge %s/%d", contract, msg.ChannelId, msg.Sequence)
+	}
+
+	m.DeletePendingAcknowledgement(ctx, msg.ChannelId, msg.Sequence)
+	if err := m.ics4Wrapper.WriteAcknowledgement(ctx, packet, types.NewRawAcknowledgement(msg.Acknowledgement, true)); err != nil {
+		return nil, err
+	}
+	return &types.MsgWriteHookAcknowledgementResponse{}, nil
+}
+
+// UpdateParams updates the module parameters. Only the module authority can update them.
+func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if m.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SubscribeTransfers subscribes the sender to the ICS-20 packets received on a channel or of a denom. Only contracts
+// can be notified, so the sender must be a contract.
+func (m msgServer) SubscribeTransfers(goCtx context.Context, msg *types.MsgSubscribeTransfers) (*types.MsgSubscribeTransfersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if !m.isContract(ctx, msg.Sender) {
+		return nil, errors.Wrapf(types.ErrBadSender, "%s is not a contract, only contracts can subscribe to transfers", msg.Sender)
+	}
+	if err := m.AddObserver(ctx, msg.ChannelId, msg.Denom, msg.Sender); err != nil {
+		return nil, err
+	}
+	return &types.MsgSubscribeTransfersResponse{}, nil
+}
+
+// UnsubscribeTransfers removes a subscription of the sender
+func (m msgServer) UnsubscribeTransfers(goCtx context.Context, msg *types.MsgUnsubscribeTransfers) (*types.MsgUnsubscribeTransfersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	m.RemoveObserver(ctx, msg.ChannelId, msg.Denom, msg.Sender)
+	return &types.MsgUnsubscribeTransfersResponse{}, nil
+}
+
+// RecoverStrandedFunds sends the funds kept by an intermediate sender after a failed contract execution to the
+// recipient, and deletes the stranded funds record. The original sender of the packet is an address of the
+// counterparty chain, so the signer must be the local account with the same address bytes, or the module authority
+// for the senders that can't sign on this chain, e.g. contracts and interchain accounts. The authority must set the
+// recipient.
+func (m msgServer) RecoverStrandedFunds(goCtx context.Context, msg *types.MsgRecoverStrandedFunds) (*types.MsgRecoverStrandedFundsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	strandedFunds, found := m.GetStrandedFunds(ctx, msg.IntermediateSender)
+	if !found {
+		return nil, errors.Wrapf(types.ErrNoStrandedFunds, "intermediate sender %s", msg.IntermediateSender)
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid sender address")
+	}
+	if msg.Sender == m.authority {
+		if msg.Recipient == "" {
+			return nil, errors.Wrap(types.ErrMsgValidation, "the authority must set the recipient of the stranded funds")
+		}
+	} else {
+		_, originalSender, err := bech32.DecodeAndConvert(strandedFunds.OriginalSender)
+		if err != nil || !bytes.Equal(originalSender, sender) {
+			return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the original sender %s or the authority can recover the funds of %s", strandedFunds.OriginalSender, msg.IntermediateSender)
+		}
+	}
+
+	recipient := sender
+	if msg.Recipient != "" {
+		if recipient, err = sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+			return nil, errors.Wrap(err, "invalid recipient address")
+		}
+	}
+
+	// The intermediate sender is derived from the channel and the original sender, so all of its funds were
+	// sent by the original sender
+	intermediateSender, err := sdk.AccAddressFromBech32(msg.IntermediateSender)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid intermediate sender address")
+	}
+	amount := m.bankKeeper.GetAllBalances(ctx, intermediateSender)
+	if !amount.IsZero() {
+		if err := m.bankKeeper.SendCoins(ctx, intermediateSender, recipient, amount); err != nil {
+			return nil, err
+		}
+	}
+	m.DeleteStrandedFunds(ctx, msg.IntermediateSender)
+
+	return &types.MsgRecoverStrandedFundsResponse{Amount: amount}, nil
+}
+
+// isPortOwner returns true if the port is the contract's wasm IBC port or its interchain accounts controller port
+func isPortOwner(portID string, contract sdk.AccAddress) bool {
+	if portID == wasmkeeper.PortIDForContract(contract) {
+		return true
+	}
+	owner, found := strings.CutPrefix(portID, icatypes.ControllerPortPrefix)
+	return found && owner == contract.String()
+}
+
+// isContract returns true if the address is the address of an instantiated contract
+func (m msgServer) isContract(ctx sdk.Context, address string) bool {
+	if m.contractKeeper == nil {
+		return false
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return false
+	}
+	return m.contractKeeper.GetContractInfo(ctx, contractAddr) != nil
+}
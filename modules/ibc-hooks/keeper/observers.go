@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+)
+
+// GetObserversPrefix returns the prefix of the observers subscribed to a channel or a denom. The value is length
+// prefixed, so the prefix of a value is never the prefix of another one.
+func GetObserversPrefix(kind, value string) []byte {
+	return append([]byte(fmt.Sprintf("%s::%s::", types.ObserverPrefix, kind)), address.MustLengthPrefix([]byte(value))...)
+}
+
+func getObserverKey(kind, value, contract string) []byte {
+	return append(GetObserversPrefix(kind, value), []byte(contract)...)
+}
+
+// subscription returns the kind and value of a subscription to a channel or a denom
+func subscription(channel, denom string) (kind, value string) {
+	if channel != "" {
+		return "channel", channel
+	}
+	return "denom", denom
+}
+
+// AddObserver subscribes the contract to the ICS-20 packets received on the channel or of the denom
+func (k Keeper) AddObserver(ctx sdk.Context, channel, denom, contract string) error {
+	kind, value := subscription(channel, denom)
+	store := ctx.KVStore(k.storeKey)
+	key := getObserverKey(kind, value, contract)
+	if store.Has(key) {
+		return nil
+	}
+
+	maxObservers := k.GetParams(ctx).MaxObservers
+	if uint64(len(k.getObservers(ctx, kind, value))) >= maxObservers {
+		return fmt.Errorf("%w: %s %s already has %d observers", types.ErrTooManyObservers, kind, value, maxObservers)
+	}
+	store.Set(key, []byte{1})
+	return nil
+}
+
+// RemoveObserver removes the subscription of the contract
+func (k Keeper) RemoveObserver(ctx sdk.Context, channel, denom, contract string) {
+	kind, value := subscription(channel, denom)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(getObserverKey(kind, value, contract))
+}
+
+// GetObservers returns the contracts subscribed to the channel or to the denom, without duplicates. The
+// observers of the channel come first.
+func (k Keeper) GetObservers(ctx sdk.Context, channel, denom string) []string {
+	observers := k.getObservers(ctx, "channel", channel)
+	seen := make(map[string]struct{}, len(observers))
+	for _, observer := range observers {
+		seen[observer] = struct{}{}
+	}
+	for _, observer := range k.getObservers(ctx, "denom", denom) {
+		if _, ok := seen[observer]; !ok {
+			observers = append(observers, observer)
+		}
+	}
+	return observers
+}
+
+func (k Keeper) getObservers(ctx sdk.Context, kind, value string) []string {
+	prefix := GetObserversPrefix(kind, value)
+	iterator := ctx.KVStore(k.storeKey).Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	defer iterator.Close()
+
+	var observers []string
+	for ; iterator.Valid(); iterator.Next() {
+		observers = append(observers, string(iterator.Key()[len(prefix):]))
+	}
+	return observers
+}
+
+// GetAllObservers returns the subscriptions of all the observers
+func (k Keeper) GetAllObservers(ctx sdk.Context) []types.Observer {
+	prefix := []byte(fmt.Sprintf("%s::", types.ObserverPrefix))
+	iterator := ctx.KVStore(k.storeKey).Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	defer iterator.Close()
+
+	var observers []types.Observer
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the kind, the length prefixed value and the contract
+		kind, rest, found := bytes.Cut(iterator.Key()[len(prefix):], []byte("::"))
+		if !found || len(rest) == 0 || len(rest) < 1+int(rest[0]) {
+			continue
+		}
+		value, contract := string(rest[1:1+int(rest[0])]), string(rest[1+int(rest[0]):])
+		observer := types.Observer{Contract: contract}
+		if string(kind) == "channel" {
+			observer.ChannelId = value
+		} else {
+			observer.Denom = value
+		}
+		observers = append(observers, observer)
+	}
+	return observers
+}
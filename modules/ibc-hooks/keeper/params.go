@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+)
+
+// GetSendGuards retrieves the contracts that guard outgoing ICS-20 packets
+func (k Keeper) GetSendGuards(ctx sdk.Context) []string {
+	return k.GetParams(ctx).SendGuards
+}
+
+// SetParams sets the module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.ParamsKey), types.ModuleCdc.MustMarshal(&p))
+	return nil
+}
+
+// GetParams returns the current module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var p types.Params
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.ParamsKey))
+	if bz == nil {
+		return p
+	}
+
+	types.ModuleCdc.MustUnmarshal(bz, &p)
+	return p
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+)
+
+// PayRelayerFee pays the relayer fee set in the memo of a packet out of the funds received by the intermediate
+// sender. The fee must be within the bounds set by governance.
+func (k Keeper) PayRelayerFee(ctx sdk.Context, intermediateSender, relayer sdk.AccAddress, fee sdk.Coin, transferred sdkmath.Int) error {
+	maxBps := k.GetParams(ctx).MaxRelayerFeeBps
+	if maxBps == 0 {
+		return fmt.Errorf("%w: relayer fees are disabled", types.ErrInvalidRelayerFee)
+	}
+	maxFee := transferred.MulRaw(int64(maxBps)).QuoRaw(int64(types.MaxBps))
+	if fee.Amount.GT(maxFee) {
+		return fmt.Errorf("%w: %s is more than the maximum of %d bps of the transferred amount", types.ErrInvalidRelayerFee, fee, maxBps)
+	}
+	if relayer.Empty() {
+		return fmt.Errorf("%w: no relayer to pay", types.ErrInvalidRelayerFee)
+	}
+	if err := k.bankKeeper.SendCoins(ctx, intermediateSender, relayer, sdk.NewCoins(fee)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"ibc-hooks-relayer-fee",
+			sdk.NewAttribute("intermediate_sender", intermediateSender.String()),
+			sdk.NewAttribute("relayer", relayer.String()),
+			sdk.NewAttribute("fee", fee.String()),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,244 @@
+package ibc_hooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+	"github.com/cosmos/ibc-apps/modules/memo"
+)
+
+// WasmMemo is the "wasm" section of the memo
+type WasmMemo struct {
+	Contract   string          `json:"contract"`
+	Msg        json.RawMessage `json:"msg"`
+	OnFailure  string          `json:"on_failure,omitempty"`
+	RelayerFee string          `json:"relayer_fee,omitempty"`
+}
+
+// ModuleMemo is the "module" section of the memo
+type ModuleMemo struct {
+	Name string          `json:"name"`
+	Msg  json.RawMessage `json:"msg"`
+}
+
+// RegisterMemoSections registers the memo keys owned by ibc-hooks ("wasm", "ibc_callback" and "module") in the
+// registry shared by the middlewares of the stack.
+func RegisterMemoSections(registry *memo.Registry) error {
+	if err := registry.Register("wasm", types.ModuleName, func(section json.RawMessage) error {
+		wasm, err := decodeWasmSection(section)
+		if err != nil {
+			return err
+		}
+		return validateWasmSection(wasm)
+	}); err != nil {
+		return err
+	}
+	if err := registry.Register(types.IBCCallbackKey, types.ModuleName, func(section json.RawMessage) error {
+		_, err := parseCallbackSection(section)
+		return err
+	}); err != nil {
+		return err
+	}
+	return registry.Register(types.ModuleHookKey, types.ModuleName, func(section json.RawMessage) error {
+		_, err := parseModuleSection(section)
+		return err
+	})
+}
+
+// parseMemo returns the top-level sections of the memo. If there is no memo, the packet was either sent with an
+// earlier version of IBC, or the memo was intentionally left blank, and memos that aren't JSON objects aren't meant
+// for any hook. In all these cases the memo has no section and the packet is passed down the stack.
+func parseMemo(memoString string) memo.Memo {
+	parsed, err := memo.Parse(memoString)
+	if err != nil {
+		return memo.Memo{}
+	}
+	return parsed
+}
+
+// ValidateAndParseMemo returns the contract and the message of the memo's wasm section, if any. The contract must be
+// the receiver of the packet.
+func ValidateAndParseMemo(memoString string, receiver string) (isWasmRouted bool, contractAddr sdk.AccAddress, msgBytes []byte, err error) {
+	section, isWasmRouted := parseMemo(memoString).Raw("wasm")
+	if !isWasmRouted {
+		return false, sdk.AccAddress{}, nil, nil
+	}
+
+	wasm, err := decodeWasmSection(section)
+	if err == nil {
+		err = validateWasmSection(wasm)
+	}
+	if err != nil {
+		return true, sdk.AccAddress{}, nil, fmt.Errorf(types.ErrBadMetadataFormatMsg, memoString, err.Error())
+	}
+	if wasm.Contract != receiver {
+		return true, sdk.AccAddress{}, nil,
+			fmt.Errorf(types.ErrBadMetadataFormatMsg, memoString, `wasm["contract"] should be the same as the receiver of the packet`)
+	}
+
+	contractAddr, err = sdk.AccAddressFromBech32(wasm.Contract)
+	if err != nil {
+		return true, sdk.AccAddress{}, nil,
+			fmt.Errorf(types.ErrBadMetadataFormatMsg, memoString, `wasm["contract"] is not a valid bech32 address`)
+	}
+	return true, contractAddr, wasm.Msg, nil
+}
+
+// ParseOnFailure returns true if the wasm memo opts into keeping the funds in the intermediate sender when the
+// contract execution fails (wasm.on_failure set to "keep"). The default, "revert", returns an error ack.
+func ParseOnFailure(memoString string) (keepOnFailure bool, err error) {
+	section, isWasmRouted := parseMemo(memoString).Raw("wasm")
+	if !isWasmRouted {
+		return false, nil
+	}
+	wasm, err := decodeWasmSection(section)
+	if err == nil {
+		keepOnFailure, err = parseOnFailure(wasm)
+	}
+	if err != nil {
+		return false, fmt.Errorf(types.ErrBadMetadataFormatMsg, memoString, err.Error())
+	}
+	return keepOnFailure, nil
+}
+
+// ParseRelayerFee returns the relayer fee set in the wasm memo (wasm.relayer_fee), as an amount of the
+// transferred denom.
+func ParseRelayerFee(memoString string) (fee sdkmath.Int, found bool, err error) {
+	section, isWasmRouted := parseMemo(memoString).Raw("wasm")
+	if !isWasmRouted {
+		return sdkmath.Int{}, false, nil
+	}
+	wasm, err := decodeWasmSection(section)
+	if err == nil {
+		fee, found, err = parseRelayerFee(wasm)
+	}
+	if err != nil {
+		return sdkmath.Int{}, false, fmt.Errorf(types.ErrBadMetadataFormatMsg, memoString, err.Error())
+	}
+	return fee, found, nil
+}
+
+// ParseCallbackMemo returns the contract set in the memo's ibc_callback key, if any. The contract must be a
+// valid bech32 address.
+func ParseCallbackMemo(memoString string) (isCallbackRouted bool, contract string, err error) {
+	section, isCallbackRouted := parseMemo(memoString).Raw(types.IBCCallbackKey)
+	if !isCallbackRouted {
+		return false, "", nil
+	}
+	contract, err = parseCallbackSection(section)
+	if err != nil {
+		return true, "", fmt.Errorf(types.ErrBadMetadataFormatMsg, memoString, err.Error())
+	}
+	return true, contract, nil
+}
+
+// ValidateAndParseModuleMemo returns the module name and the JSON encoded message of the memo's module section, if
+// any.
+func ValidateAndParseModuleMemo(memoString string) (isModuleRouted bool, moduleName string, msgBytes []byte, err error) {
+	parsed := parseMemo(memoString)
+	section, isModuleRouted := parsed.Raw(types.ModuleHookKey)
+	if !isModuleRouted {
+		return false, "", nil, nil
+	}
+
+	module, err := parseModuleSection(section)
+	if err != nil {
+		return true, "", nil, fmt.Errorf(types.ErrBadMetadataFormatMsg, memoString, err.Error())
+	}
+	// Both hooks send the funds to the same intermediate sender, so a packet can't be routed to both of them
+	if parsed.Has("wasm") {
+		return true, "", nil,
+			fmt.Errorf(types.ErrBadMetadataFormatMsg, memoString, "a memo can't contain both the wasm and module keys")
+	}
+	// The codec decodes the message as a json encoded Any
+	return true, module.Name, module.Msg, nil
+}
+
+func decodeWasmSection(section json.RawMessage) (WasmMemo, error) {
+	var wasm WasmMemo
+	if err := json.Unmarshal(section, &wasm); err != nil {
+		return WasmMemo{}, fmt.Errorf("wasm metadata is not a valid JSON map object: %w", err)
+	}
+	return wasm, nil
+}
+
+func validateWasmSection(wasm WasmMemo) error {
+	if wasm.Contract == "" {
+		return fmt.Errorf(`Could not find key wasm["contract"]`)
+	}
+	if _, err := sdk.AccAddressFromBech32(wasm.Contract); err != nil {
+		return fmt.Errorf(`wasm["contract"] is not a valid bech32 address`)
+	}
+	if err := validateMsgObject("wasm", wasm.Msg); err != nil {
+		return err
+	}
+	if _, err := parseOnFailure(wasm); err != nil {
+		return err
+	}
+	_, _, err := parseRelayerFee(wasm)
+	return err
+}
+
+func parseOnFailure(wasm WasmMemo) (keepOnFailure bool, err error) {
+	switch wasm.OnFailure {
+	case types.OnFailureKeep:
+		return true, nil
+	case "", types.OnFailureRevert:
+		return false, nil
+	default:
+		return false, fmt.Errorf(`wasm["on_failure"] must be either "keep" or "revert"`)
+	}
+}
+
+func parseRelayerFee(wasm WasmMemo) (fee sdkmath.Int, found bool, err error) {
+	if wasm.RelayerFee == "" {
+		return sdkmath.Int{}, false, nil
+	}
+	fee, ok := sdkmath.NewIntFromString(wasm.RelayerFee)
+	if !ok || !fee.IsPositive() {
+		return sdkmath.Int{}, false, fmt.Errorf(`wasm["relayer_fee"] must be a positive integer`)
+	}
+	return fee, true, nil
+}
+
+func parseCallbackSection(section json.RawMessage) (string, error) {
+	var contract string
+	if err := json.Unmarshal(section, &contract); err != nil {
+		return "", fmt.Errorf("ibc_callback is not a string")
+	}
+	if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+		return "", fmt.Errorf("ibc_callback is not a valid bech32 address")
+	}
+	return contract, nil
+}
+
+func parseModuleSection(section json.RawMessage) (ModuleMemo, error) {
+	var module ModuleMemo
+	if err := json.Unmarshal(section, &module); err != nil {
+		return ModuleMemo{}, fmt.Errorf("module metadata is not a valid JSON map object: %w", err)
+	}
+	if module.Name == "" {
+		return ModuleMemo{}, fmt.Errorf(`Could not find key module["name"]`)
+	}
+	if err := validateMsgObject("module", module.Msg); err != nil {
+		return ModuleMemo{}, err
+	}
+	return module, nil
+}
+
+// validateMsgObject checks that the msg of the section is a JSON object
+func validateMsgObject(key string, msg json.RawMessage) error {
+	msg = bytes.TrimSpace(msg)
+	if len(msg) == 0 || bytes.Equal(msg, []byte("null")) {
+		return fmt.Errorf(`Could not find key %s["msg"]`, key)
+	}
+	if msg[0] != '{' {
+		return fmt.Errorf(`%s["msg"] is not a map object`, key)
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package ibc_hooks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/keeper"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+var _ OnRecvPacketOverrideHooks = ModuleHooks{}
+
+type ModuleAck struct {
+	ModuleResult []byte `json:"module_result"`
+	IbcAck       []byte `json:"ibc_ack"`
+}
+
+// ModuleHooks is the native Go counterpart of WasmHooks. Instead of executing a contract, it dispatches the
+// sdk.Msg found in the "module" key of an ICS20 memo to the module registered under the given name.
+// The message is executed on behalf of the intermediate sender derived from the packet's channel and sender.
+//
+// The memo format is: {"module": {"name": "staking", "msg": {"@type": "/cosmos.staking.v1beta1.MsgDelegate", ...}}}
+//
+// ModuleHooks only override OnRecvPacket. To use them alongside WasmHooks, give each hook its own ICS4Middleware
+// and IBCMiddleware and nest the module hooks middleware inside the wasm hooks middleware. Packets that aren't
+// routed to the outer hook are passed down the stack unchanged.
+type ModuleHooks struct {
+	cdc                 codec.Codec
+	router              baseapp.MessageRouter
+	bech32PrefixAccAddr string
+
+	// allowedMsgs maps a registered module name to the set of msg type urls it is allowed to run
+	allowedMsgs map[string]map[string]struct{}
+}
+
+func NewModuleHooks(cdc codec.Codec, router baseapp.MessageRouter, bech32PrefixAccAddr string) ModuleHooks {
+	return ModuleHooks{
+		cdc:                 cdc,
+		router:              router,
+		bech32PrefixAccAddr: bech32PrefixAccAddr,
+		allowedMsgs:         make(map[string]map[string]struct{}),
+	}
+}
+
+// RegisterModule registers a handler under the given name that is allowed to run the listed msg types.
+// Msg types are identified by their type url (i.e.: sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})).
+// Registering the same name twice extends the list of allowed messages.
+func (h ModuleHooks) RegisterModule(name string, msgTypeURLs ...string) {
+	if name == "" {
+		panic("cannot register a module hook with an empty name")
+	}
+	allowed, ok := h.allowedMsgs[name]
+	if !ok {
+		allowed = make(map[string]struct{})
+		h.allowedMsgs[name] = allowed
+	}
+	for _, typeURL := range msgTypeURLs {
+		if h.router.HandlerByTypeURL(typeURL) == nil {
+			panic(fmt.Sprintf("cannot register module hook %s: no handler for msg %s", name, typeURL))
+		}
+		allowed[typeURL] = struct{}{}
+	}
+}
+
+// IsAllowed returns true if the module registered under name is allowed to run the msg type
+func (h ModuleHooks) IsAllowed(name, msgTypeURL string) bool {
+	allowed, ok := h.allowedMsgs[name]
+	if !ok {
+		return false
+	}
+	_, ok = allowed[msgTypeURL]
+	return ok
+}
+
+func (h ModuleHooks) ProperlyConfigured() bool {
+	return h.cdc != nil && h.router != nil && len(h.allowedMsgs) > 0
+}
+
+func (h ModuleHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	if !h.ProperlyConfigured() {
+		// Not configured
+		return im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+	isIcs20, data := isIcs20Packet(packet.GetData())
+	if !isIcs20 {
+		return im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	// Validate the memo
+	isModuleRouted, moduleName, msgBytes, err := ValidateAndParseModuleMemo(data.GetMemo())
+	if !isModuleRouted {
+		return im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation, err.Error())
+	}
+
+	// Calculate the local account acting on behalf of the packet's sender
+	channel := packet.GetDestChannel()
+	sender := data.GetSender()
+	senderBech32, err := keeper.DeriveIntermediateSender(channel, sender, h.bech32PrefixAccAddr)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrBadSender, fmt.Sprintf("cannot convert sender address %s/%s to bech32: %s", channel, sender, err.Error()))
+	}
+
+	msg, err := h.decodeModuleMsg(moduleName, msgBytes, senderBech32)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation, err.Error())
+	}
+
+	// As with wasm hooks, the funds are sent to the intermediate sender so that the message
+	// can spend them.
+	data.Receiver = senderBech32
+	bz, err := json.Marshal(data)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrMarshaling, err.Error())
+	}
+	packet.Data = bz
+
+	// Execute the receive
+	ack := im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	result, err := h.execModuleMsg(ctx, msg)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrModuleHookError, err.Error())
+	}
+
+	// The msg service router runs the handler with its own event manager, so the events of the msg are
+	// only returned in the result.
+	events := make(sdk.Events, 0, len(result.Events))
+	for _, event := range result.Events {
+		events = append(events, sdk.Event(event))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	fullAck := ModuleAck{ModuleResult: result.Data, IbcAck: ack.Acknowledgement()}
+	bz, err = json.Marshal(fullAck)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrBadResponse, err.Error())
+	}
+
+	return channeltypes.NewResultAcknowledgement(bz)
+}
+
+// decodeModuleMsg unpacks the msg in the memo and makes sure it can be run by the module hook
+// on behalf of the intermediate sender.
+func (h ModuleHooks) decodeModuleMsg(moduleName string, msgBytes []byte, senderBech32 string) (sdk.Msg, error) {
+	if _, ok := h.allowedMsgs[moduleName]; !ok {
+		return nil, fmt.Errorf("no module hook registered for %s", moduleName)
+	}
+
+	var msg sdk.Msg
+	if err := h.cdc.UnmarshalInterfaceJSON(msgBytes, &msg); err != nil {
+		return nil, fmt.Errorf(types.ErrBadModuleMsg, err.Error())
+	}
+
+	typeURL := sdk.MsgTypeURL(msg)
+	if !h.IsAllowed(moduleName, typeURL) {
+		return nil, fmt.Errorf("msg %s is not allowed for module hook %s", typeURL, moduleName)
+	}
+
+	signers, _, err := h.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return nil, fmt.Errorf(types.ErrBadModuleMsg, err.Error())
+	}
+	if len(signers) != 1 {
+		return nil, fmt.Errorf(types.ErrBadModuleMsg, "msg must have exactly one signer")
+	}
+	signer, err := sdk.Bech32ifyAddressBytes(h.bech32PrefixAccAddr, signers[0])
+	if err != nil {
+		return nil, fmt.Errorf(types.ErrBadModuleMsg, err.Error())
+	}
+	if signer != senderBech32 {
+		return nil, fmt.Errorf(types.ErrBadModuleMsg, fmt.Sprintf("msg signer %s should be the intermediate sender %s", signer, senderBech32))
+	}
+
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf(types.ErrBadModuleMsg, err.Error())
+		}
+	}
+
+	return msg, nil
+}
+
+func (h ModuleHooks) execModuleMsg(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	handler := h.router.Handler(msg)
+	if handler == nil {
+		return nil, fmt.Errorf("no handler found for msg %s", sdk.MsgTypeURL(msg))
+	}
+	return handler(ctx, msg)
+}
@@ -0,0 +1,120 @@
+package ibc_hooks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+var _ OnRecvPacketAfterHooks = ObserverHooks{}
+
+// ObserverHooks notifies the contracts subscribed with MsgSubscribeTransfers of the ICS-20 packets successfully
+// received on a channel or of a denom. Each notification runs with the gas limit set in the params, and the failure
+// of an observer never affects the acknowledgement of the packet.
+//
+// The hooks only implement OnRecvPacketAfterHook, so they must be used by their own IBCMiddleware. To notify the
+// observers after the wasm hooks have been executed, that middleware must wrap the one of the WasmHooks.
+type ObserverHooks struct {
+	ContractKeeper *wasmkeeper.Keeper
+	ibcHooksKeeper *keeper.Keeper
+}
+
+func NewObserverHooks(ibcHooksKeeper *keeper.Keeper, contractKeeper *wasmkeeper.Keeper) ObserverHooks {
+	return ObserverHooks{
+		ContractKeeper: contractKeeper,
+		ibcHooksKeeper: ibcHooksKeeper,
+	}
+}
+
+// TransferReceivedSudoMsg is the sudo message sent to the observers of a received ICS-20 packet
+type TransferReceivedSudoMsg struct {
+	IBCTransferReceived IBCTransferReceived `json:"ibc_transfer_received"`
+}
+
+type IBCTransferReceived struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	// Denom is the denom of the received funds on this chain
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+	Memo   string `json:"memo"`
+}
+
+func (h ObserverHooks) OnRecvPacketAfterHook(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress, ack ibcexported.Acknowledgement) {
+	// asynchronous and failed receives are not notified
+	if ack == nil || !ack.Success() {
+		return
+	}
+	h.NotifyTransferReceived(ctx, packet)
+}
+
+// NotifyTransferReceived notifies the observers of an ICS-20 packet that has been successfully received. For IBC v2
+// packets, the channels of the packet are the client IDs.
+func (h ObserverHooks) NotifyTransferReceived(ctx sdk.Context, packet channeltypes.Packet) {
+	if h.ContractKeeper == nil || h.ibcHooksKeeper == nil {
+		return
+	}
+	isIcs20, data := isIcs20Packet(packet.GetData())
+	if !isIcs20 {
+		return
+	}
+
+	denom := localDenomOnRecv(packet, data.Denom)
+	observers := h.ibcHooksKeeper.GetObservers(ctx, packet.GetDestChannel(), denom)
+	if len(observers) == 0 {
+		return
+	}
+
+	sudoMsg, err := json.Marshal(TransferReceivedSudoMsg{
+		IBCTransferReceived: IBCTransferReceived{
+			Channel:  packet.GetDestChannel(),
+			Sequence: packet.GetSequence(),
+			Sender:   data.Sender,
+			Receiver: data.Receiver,
+			Denom:    denom,
+			Amount:   data.Amount,
+			Memo:     data.Memo,
+		},
+	})
+	if err != nil {
+		return
+	}
+
+	gasLimit := h.ibcHooksKeeper.GetParams(ctx).ObserverGasLimit
+	for _, observer := range observers {
+		if err := h.notify(ctx, observer, sudoMsg, gasLimit); err != nil {
+			h.ibcHooksKeeper.Logger(ctx).Debug("observer notification failed", "contract", observer, "error", err)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					"ibc-observer-error",
+					sdk.NewAttribute("contract", observer),
+					sdk.NewAttribute("channel", packet.GetDestChannel()),
+					sdk.NewAttribute("sequence", fmt.Sprintf("%d", packet.GetSequence())),
+					sdk.NewAttribute("error", err.Error()),
+				),
+			)
+		}
+	}
+}
+
+// notify sends the sudo message to the observer with its own gas meter. The state changes of the observer are only
+// written if it succeeds, and the gas it used is always charged to the packet.
+func (h ObserverHooks) notify(ctx sdk.Context, observer string, sudoMsg []byte, gasLimit uint64) error {
+	contractAddr, err := sdk.AccAddressFromBech32(observer)
+	if err != nil {
+		return err
+	}
+
+	return keeper.ExecuteWithGasLimit(ctx, gasLimit, "ibc-hooks observer", func(cacheCtx sdk.Context) error {
+		_, err := h.ContractKeeper.Sudo(cacheCtx, contractAddr, sudoMsg)
+		return err
+	})
+}
@@ -0,0 +1,153 @@
+package ibc_hooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+// FundsPacketData abstracts over the packet types that can carry assets into a wasm hook.
+type FundsPacketData interface {
+	GetSender() string
+	GetReceiver() string
+	GetMemo() string
+
+	// RecvPacketData returns the packet data that should be passed to the underlying application when the
+	// packet triggers a hook. Fungible tokens are redirected to the intermediate sender so they can be
+	// attached as funds to the contract execution.
+	RecvPacketData(intermediateSender string) ([]byte, error)
+
+	// ExecuteParams returns the funds and the contract message to execute once the underlying application
+	// has successfully received the packet. msg is the message provided in the memo.
+	ExecuteParams(packet ibcexported.PacketI, msg []byte) (funds sdk.Coins, execMsg []byte, err error)
+}
+
+var (
+	_ FundsPacketData = (*ics20V1PacketData)(nil)
+	_ FundsPacketData = NonFungibleTokenPacketData{}
+)
+
+// ParseFundsPacketData tries to decode the packet data with each of the supported adapters: ICS-20 v1 and ICS-721.
+// Decoding is strict, so a packet only matches a single type. The multi-token ICS-20 v2 packets aren't supported, as
+// the transfer application of ibc-go v11 only negotiates the ics20-1 version.
+func ParseFundsPacketData(data []byte) (FundsPacketData, bool) {
+	if isIcs20, ics20data := isIcs20Packet(data); isIcs20 {
+		return &ics20V1PacketData{ics20data}, true
+	}
+
+	var nftData NonFungibleTokenPacketData
+	if err := strictUnmarshal(data, &nftData); err == nil && nftData.ClassID != "" {
+		return nftData, true
+	}
+
+	return nil, false
+}
+
+func strictUnmarshal(data []byte, v interface{}) error {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	return d.Decode(v)
+}
+
+// ics20V1PacketData adapts the ICS-20 v1 FungibleTokenPacketData
+type ics20V1PacketData struct {
+	transfertypes.FungibleTokenPacketData
+}
+
+func (d ics20V1PacketData) RecvPacketData(intermediateSender string) ([]byte, error) {
+	d.Receiver = intermediateSender
+	return json.Marshal(d.FungibleTokenPacketData)
+}
+
+func (d ics20V1PacketData) ExecuteParams(packet ibcexported.PacketI, msg []byte) (sdk.Coins, []byte, error) {
+	amount, ok := sdkmath.NewIntFromString(d.GetAmount())
+	if !ok {
+		return nil, nil, fmt.Errorf("amount %s is not an int", d.GetAmount())
+	}
+
+	// The packet's denom is the denom in the sender chain. This needs to be converted to the local denom.
+	denom := localDenomOnRecv(packet, d.Denom)
+	return sdk.NewCoins(sdk.NewCoin(denom, amount)), msg, nil
+}
+
+// NonFungibleTokenPacketData is the ICS-721 packet data.
+type NonFungibleTokenPacketData struct {
+	ClassID   string   `json:"classId"`
+	ClassURI  string   `json:"classUri,omitempty"`
+	ClassData string   `json:"classData,omitempty"`
+	TokenIDs  []string `json:"tokenIds"`
+	TokenURIs []string `json:"tokenUris,omitempty"`
+	TokenData []string `json:"tokenData,omitempty"`
+	Sender    string   `json:"sender"`
+	Receiver  string   `json:"receiver"`
+	Memo      string   `json:"memo,omitempty"`
+}
+
+// NFTHookMsg is the message executed on the contract when an ICS-721 packet triggers a wasm hook. It wraps
+// the msg provided in the memo with the information of the received NFTs.
+type NFTHookMsg struct {
+	IBCNFTReceived IBCNFTReceived `json:"ibc_nft_received"`
+}
+
+type IBCNFTReceived struct {
+	// ClassID is the class of the NFTs as represented in the local chain
+	ClassID  string          `json:"class_id"`
+	TokenIDs []string        `json:"token_ids"`
+	Msg      json.RawMessage `json:"msg"`
+}
+
+func (d NonFungibleTokenPacketData) GetSender() string   { return d.Sender }
+func (d NonFungibleTokenPacketData) GetReceiver() string { return d.Receiver }
+func (d NonFungibleTokenPacketData) GetMemo() string     { return d.Memo }
+
+// RecvPacketData keeps the receiver untouched. The memo validation ensures the receiver is the contract,
+// so the NFTs are owned by the contract once received.
+func (d NonFungibleTokenPacketData) RecvPacketData(_ string) ([]byte, error) {
+	return json.Marshal(d)
+}
+
+func (d NonFungibleTokenPacketData) ExecuteParams(packet ibcexported.PacketI, msg []byte) (sdk.Coins, []byte, error) {
+	execMsg, err := json.Marshal(NFTHookMsg{
+		IBCNFTReceived: IBCNFTReceived{
+			ClassID:  localDenomOnRecv(packet, d.ClassID),
+			TokenIDs: d.TokenIDs,
+			Msg:      msg,
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return sdk.NewCoins(), execMsg, nil
+}
+
+// localDenomOnRecv converts the denom (or class id) of a received packet, as represented in the sender chain,
+// to its representation in the local chain.
+func localDenomOnRecv(packet ibcexported.PacketI, packetDenom string) string {
+	var denom string
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), packetDenom) {
+		// if we receive back a token, that was originally sent from "this" chain, then we need to remove
+		// prefix added by the sender chain: port/channel/base_denom -> base_denom.
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedDenom := packetDenom[len(voucherPrefix):]
+
+		// coin denomination used in sending from the escrow address
+		denom = unprefixedDenom
+
+		// The denomination used to send the coins is either the native denom or the hash of the path
+		// if the denomination is not native.
+		denomTrace := transfertypes.ExtractDenomFromPath(unprefixedDenom)
+		if !denomTrace.IsNative() {
+			denom = denomTrace.IBCDenom()
+		}
+	} else {
+		prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + packetDenom
+		denom = transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+	}
+	return denom
+}
@@ -490,6 +490,10 @@ func NewSimApp(
 	// ibc-hooks: create the ICS4 middleware wrapper around the transfer keeper
 	app.IBCHooksKeeper = ibchookskeeper.NewKeeper(
 		keys[ibchookstypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper,
+		app.BankKeeper,
+		govModAddress,
 	)
 	ics20WasmHooks := ibchooks.NewWasmHooks(&app.IBCHooksKeeper, nil, AccountAddressPrefix) // contract keeper set later
 	hooksICS4Wrapper := ibchooks.NewICS4Middleware(
@@ -497,6 +501,7 @@ func NewSimApp(
 		ics20WasmHooks,
 	)
 	app.TransferKeeper.WithICS4Wrapper(&hooksICS4Wrapper)
+	app.IBCHooksKeeper.WithICS4Wrapper(&hooksICS4Wrapper)
 
 	// CosmWasm
 	wasmDir := filepath.Join(homePath, "wasm")
@@ -529,24 +534,37 @@ func NewSimApp(
 
 	// now set contract keeper on wasm hooks
 	ics20WasmHooks.ContractKeeper = &app.WasmKeeper
+	app.IBCHooksKeeper.WithContractKeeper(&app.WasmKeeper)
 
 	// IBC stack wiring
-	wasmStackIBCHandler := wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper, app.TransferKeeper, app.IBCKeeper.ChannelKeeper)
+	// The wasm and ICA controller stacks are wrapped with the ibc-hooks middleware, so that the callbacks registered
+	// with MsgRegisterPacketCallback for the packets of the contracts' ports are delivered
+	wasmStackIBCHandler := ibchooks.NewIBCMiddleware(
+		wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper, app.TransferKeeper, app.IBCKeeper.ChannelKeeper),
+		&hooksICS4Wrapper,
+	)
 
 	// ICA controller stack
-	icaControllerStack := icacontroller.NewIBCMiddleware(app.ICAControllerKeeper)
+	icaControllerStack := ibchooks.NewIBCMiddleware(icacontroller.NewIBCMiddleware(app.ICAControllerKeeper), &hooksICS4Wrapper)
 
 	// Transfer stack with ibc-hooks middleware
 	ibcHooksMiddleware := ibchooks.NewIBCMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), &hooksICS4Wrapper)
 
+	// Observers are notified after the wasm hooks, so they wrap the ibc-hooks middleware
+	observerICS4Wrapper := ibchooks.NewICS4Middleware(
+		&hooksICS4Wrapper,
+		ibchooks.NewObserverHooks(&app.IBCHooksKeeper, &app.WasmKeeper),
+	)
+	observerMiddleware := ibchooks.NewIBCMiddleware(&ibcHooksMiddleware, &observerICS4Wrapper)
+
 	// ICA host stack
 	icaHostStack := icahost.NewIBCModule(app.ICAHostKeeper)
 
 	// Set IBC router
 	ibcRouter := ibcporttypes.NewRouter().
-		AddRoute(ibctransfertypes.ModuleName, &ibcHooksMiddleware).
-		AddRoute(wasmtypes.ModuleName, wasmStackIBCHandler).
-		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
+		AddRoute(ibctransfertypes.ModuleName, &observerMiddleware).
+		AddRoute(wasmtypes.ModuleName, &wasmStackIBCHandler).
+		AddRoute(icacontrollertypes.SubModuleName, &icaControllerStack).
 		AddRoute(icahosttypes.SubModuleName, icaHostStack)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
@@ -579,7 +597,7 @@ func NewSimApp(
 		ica.NewAppModule(app.ICAControllerKeeper, app.ICAHostKeeper),
 		ibctm.NewAppModule(tmLightClientModule),
 		// ibc-hooks
-		ibchooks.NewAppModule(app.AccountKeeper),
+		ibchooks.NewAppModule(app.AccountKeeper, app.IBCHooksKeeper),
 		// CosmWasm
 		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.MsgServiceRouter(), nil),
 	)
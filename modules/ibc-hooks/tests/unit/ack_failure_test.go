@@ -0,0 +1,67 @@
+package tests_unit
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11"
+)
+
+func TestParseAckFailure(t *testing.T) {
+	oneHop := `{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-1"}}`
+	twoHops := `{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-1","next":"{\"forward\":{\"receiver\":\"juno1receiver\",\"port\":\"transfer\",\"channel\":\"channel-2\"}}"}}`
+	errorAck := func(err string) []byte { return []byte(fmt.Sprintf(`{"error":%q}`, err)) }
+
+	testCases := []struct {
+		name         string
+		ack          []byte
+		memo         string
+		expFailure   bool
+		expCode      uint32
+		expCodespace string
+		expForward   bool
+		expHops      uint32
+		expHop       int
+		expChannel   string
+	}{
+		{"success ack", []byte(`{"result":"AQ=="}`), oneHop, false, 0, "", false, 0, 0, ""},
+		{"not json", []byte("not an ack"), "", false, 0, "", false, 0, 0, ""},
+		{"receiver error", errorAck("ABCI code: 5: error handling packet: see events for details"), "", true, 5, "", false, 0, 0, "channel-0"},
+		{"receiver error with codespace", errorAck("ABCI error: wasm/6: error handling packet: see events for details"), "", true, 6, "wasm", false, 0, 0, "channel-0"},
+		// ibc-go's packet forward middleware writes the errors of the intermediate chains as regular error acks, which
+		// can't be told apart from the error ack of the final destination
+		{"forwarded packet error", errorAck("ABCI code: 5: error handling packet: see events for details"), twoHops, true, 5, "", false, 2, -1, ""},
+		{"single forward error", errorAck("ABCI code: 2: error handling packet: see events for details"), oneHop, true, 2, "", false, 1, -1, ""},
+		{"ibc-apps forward error", errorAck("packet-forward-middleware error: error receiving packet: ack: ABCI code: 2: error handling packet: see events for details"), oneHop, true, 2, "", true, 1, -1, ""},
+		{"null forward", errorAck("ABCI code: 5: error handling packet: see events for details"), `{"forward":null}`, true, 5, "", false, 0, 0, "channel-0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			failure := ibc_hooks.ParseAckFailure(tc.ack, "channel-0", tc.memo)
+			if !tc.expFailure {
+				require.Nil(t, failure)
+				return
+			}
+			require.NotNil(t, failure)
+			if tc.expCode == 0 {
+				require.Nil(t, failure.Code)
+			} else {
+				require.NotNil(t, failure.Code)
+				require.Equal(t, tc.expCode, *failure.Code)
+			}
+			require.Equal(t, tc.expCodespace, failure.Codespace)
+			require.Equal(t, tc.expForward, failure.ForwardError)
+			require.Equal(t, tc.expHops, failure.Hops)
+			if tc.expHop < 0 {
+				require.Nil(t, failure.Hop)
+			} else {
+				require.NotNil(t, failure.Hop)
+				require.Equal(t, uint32(tc.expHop), *failure.Hop)
+			}
+			require.Equal(t, tc.expChannel, failure.Channel)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package tests_unit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11"
+	ibchookskeeper "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/keeper"
+	ibchookstypes "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+)
+
+func TestParseHookAckResponse(t *testing.T) {
+	testCases := []struct {
+		name           string
+		contractResult string
+		expRaw         bool
+		expAsync       bool
+	}{
+		{"raw ack", `{"ibc_hooks_ack":{"raw":{"ack":"eyJyZXN1bHQiOiJBUT09In0=","success":true}}}`, true, false},
+		{"async ack", `{"ibc_hooks_ack":{"async":{}}}`, false, true},
+		{"both raw and async", `{"ibc_hooks_ack":{"raw":{"ack":"AQ==","success":true},"async":{}}}`, false, false},
+		{"neither raw nor async", `{"ibc_hooks_ack":{}}`, false, false},
+		{"regular contract result", `{"count":1}`, false, false},
+		{"extra fields", `{"ibc_hooks_ack":{"async":{}},"count":1}`, false, false},
+		{"not json", `this should echo`, false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hookAck, ok := ibc_hooks.ParseHookAckResponse([]byte(tc.contractResult))
+			require.Equal(t, tc.expRaw || tc.expAsync, ok)
+			require.Equal(t, tc.expRaw, hookAck.Raw != nil)
+			require.Equal(t, tc.expAsync, hookAck.Async != nil)
+		})
+	}
+}
+
+func TestValidateRawAcknowledgement(t *testing.T) {
+	testCases := []struct {
+		name    string
+		ack     string
+		success bool
+		expPass bool
+	}{
+		{"successful result ack", `{"result":"AQ=="}`, true, true},
+		{"failed error ack", `{"error":"failed"}`, false, true},
+		{"result ack claimed as failed", `{"result":"AQ=="}`, false, false},
+		{"error ack claimed as successful", `{"error":"failed"}`, true, false},
+		{"empty result", `{"result":""}`, true, false},
+		{"not a channel ack", `{"count":1}`, true, false},
+		{"not json", `AQ==`, true, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ibchookstypes.ValidateRawAcknowledgement([]byte(tc.ack), tc.success)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ibchookstypes.ErrInvalidAck)
+			}
+		})
+	}
+}
+
+func (suite *HooksTestSuite) TestWriteHookAcknowledgement() {
+	suite.SetupEnv()
+	msgServer := ibchookskeeper.NewMsgServerImpl(suite.App.IBCHooksKeeper)
+	contract := suite.EchoContractAddr.String()
+
+	suite.App.IBCKeeper.ChannelKeeper.SetChannel(suite.Ctx, transfertypes.PortID, "channel-0", channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(transfertypes.PortID, testSourceChannel),
+		[]string{"connection-0"}, transfertypes.V1,
+	))
+	packet := channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         testSourcePort,
+		SourceChannel:      testSourceChannel,
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: "channel-0",
+	}
+	// the contract returned an async ack when the packet was received
+	suite.App.IBCHooksKeeper.StorePendingAcknowledgement(suite.Ctx, packet, contract)
+
+	write := func(sender, ack string) error {
+		_, err := msgServer.WriteHookAcknowledgement(suite.Ctx, ibchookstypes.NewMsgWriteHookAcknowledgement(sender, "channel-0", 1, []byte(ack)))
+		return err
+	}
+	resultAck := `{"result":"AQ=="}`
+
+	// only the contract the packet was routed to can acknowledge it
+	suite.Require().ErrorIs(write(suite.CounterContractAddr.String(), resultAck), sdkerrors.ErrUnauthorized)
+
+	// the ack must be a successful channel ack
+	suite.Require().ErrorIs(write(contract, `{"error":"failed"}`), ibchookstypes.ErrInvalidAck)
+	suite.Require().ErrorIs(write(contract, `{"count":1}`), ibchookstypes.ErrInvalidAck)
+	suite.Require().ErrorIs(write(contract, `this should echo`), ibchookstypes.ErrInvalidAck)
+
+	// the ack is written once
+	suite.Require().NoError(write(contract, resultAck))
+	commitment, found := suite.App.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(suite.Ctx, transfertypes.PortID, "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement([]byte(resultAck)), commitment)
+	_, _, found = suite.App.IBCHooksKeeper.GetPendingAcknowledgement(suite.Ctx, "channel-0", 1)
+	suite.Require().False(found)
+	suite.Require().ErrorIs(write(contract, resultAck), ibchookstypes.ErrPendingAckNotFound)
+}
@@ -0,0 +1,125 @@
+package tests_unit
+
+import (
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/simapp"
+	ibchookstypes "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+)
+
+func (suite *HooksTestSuite) TestGenesisRoundTrip() {
+	suite.SetupEnv()
+	keeper := suite.App.IBCHooksKeeper
+	contract := suite.CounterContractAddr.String()
+	sender := suite.TestAddress.GetAddress().String()
+
+	suite.Require().NoError(keeper.StorePacketCallback(suite.Ctx, ibchookstypes.PacketCallbackRecord{
+		Contract:         contract,
+		PortId:           "transfer",
+		ChannelId:        "channel-0",
+		Sequence:         1,
+		Sender:           sender,
+		TimeoutTimestamp: 100,
+	}))
+	keeper.StorePendingAcknowledgement(suite.Ctx, channeltypes.NewPacket(
+		[]byte("data"), 2, "transfer", "channel-1", "transfer", "channel-2", clienttypes.NewHeight(1, 100), 0,
+	), contract)
+	keeper.SetCallbackAuthorization(suite.Ctx, contract, sender, true)
+	keeper.SetStrandedFunds(suite.Ctx, ibchookstypes.StrandedFunds{
+		Address:        suite.EchoContractAddr.String(),
+		ChannelId:      "channel-2",
+		OriginalSender: "osmo1sender",
+	})
+	suite.Require().NoError(keeper.AddObserver(suite.Ctx, "channel-0", "", contract))
+	suite.Require().NoError(keeper.AddObserver(suite.Ctx, "", "ibc/ABC", contract))
+	suite.Require().NoError(keeper.RecordIntermediateSender(suite.Ctx, suite.EchoContractAddr.String(), "channel-2", "osmo1sender"))
+
+	exported := keeper.ExportGenesis(suite.Ctx)
+	suite.Require().NoError(exported.Validate())
+	suite.Require().Len(exported.Callbacks, 1)
+	suite.Require().Len(exported.PendingAcknowledgements, 1)
+	suite.Require().Equal([]ibchookstypes.CallbackAuthorization{{Contract: contract, Sender: sender}}, exported.CallbackAuthorizations)
+	suite.Require().Len(exported.StrandedFunds, 1)
+	suite.Require().ElementsMatch([]ibchookstypes.Observer{
+		{Contract: contract, ChannelId: "channel-0"},
+		{Contract: contract, Denom: "ibc/ABC"},
+	}, exported.Observers)
+	suite.Require().Equal([]ibchookstypes.IntermediateSender{{
+		Address: suite.EchoContractAddr.String(),
+		Origin:  ibchookstypes.IntermediateSenderOrigin{ChannelId: "channel-2", OriginalSender: "osmo1sender"},
+	}}, exported.IntermediateSenders)
+
+	// the state is imported as exported, including the blocks the callbacks were registered at
+	app, ctx, _ := simapp.Setup(suite.T())
+	app.IBCHooksKeeper.InitGenesis(ctx, *exported)
+	suite.Require().Equal(exported, app.IBCHooksKeeper.ExportGenesis(ctx))
+
+	_, pendingContract, found := app.IBCHooksKeeper.GetPendingAcknowledgement(ctx, "channel-2", 2)
+	suite.Require().True(found)
+	suite.Require().Equal(contract, pendingContract)
+	suite.Require().True(app.IBCHooksKeeper.IsCallbackAuthorized(ctx, contract, sender))
+	suite.Require().Equal([]string{contract}, app.IBCHooksKeeper.GetObservers(ctx, "channel-0", "ibc/ABC"))
+}
+
+func (suite *HooksTestSuite) TestGenesisValidate() {
+	suite.SetupEnv()
+	contract := suite.CounterContractAddr.String()
+	packet := channeltypes.SubModuleCdc.MustMarshal(&channeltypes.Packet{
+		Data: []byte("data"), Sequence: 1, SourcePort: "transfer", SourceChannel: "channel-0",
+		DestinationPort: "transfer", DestinationChannel: "channel-1", TimeoutTimestamp: 1,
+	})
+	callback := ibchookstypes.PacketCallbackRecord{Contract: contract, ChannelId: "channel-0", Sequence: 1}
+	observer := ibchookstypes.Observer{Contract: contract, Denom: "stake"}
+
+	testCases := []struct {
+		name     string
+		malleate func(gs *ibchookstypes.GenesisState)
+		expErr   bool
+	}{
+		{"default", func(gs *ibchookstypes.GenesisState) {}, false},
+		{"valid state", func(gs *ibchookstypes.GenesisState) {
+			gs.Callbacks = []ibchookstypes.PacketCallbackRecord{callback}
+			gs.PendingAcknowledgements = []ibchookstypes.PendingAcknowledgement{{Contract: contract, Packet: packet}}
+			gs.Observers = []ibchookstypes.Observer{observer}
+		}, false},
+		{"duplicate callback", func(gs *ibchookstypes.GenesisState) {
+			gs.Callbacks = []ibchookstypes.PacketCallbackRecord{callback, callback}
+		}, true},
+		{"invalid pending ack packet", func(gs *ibchookstypes.GenesisState) {
+			gs.PendingAcknowledgements = []ibchookstypes.PendingAcknowledgement{{Contract: contract, Packet: []byte("invalid")}}
+		}, true},
+		{"invalid authorization sender", func(gs *ibchookstypes.GenesisState) {
+			gs.CallbackAuthorizations = []ibchookstypes.CallbackAuthorization{{Contract: contract, Sender: "invalid"}}
+		}, true},
+		{"stranded funds without origin", func(gs *ibchookstypes.GenesisState) {
+			gs.StrandedFunds = []ibchookstypes.StrandedFunds{{Address: contract}}
+		}, true},
+		{"observer of a channel and a denom", func(gs *ibchookstypes.GenesisState) {
+			gs.Observers = []ibchookstypes.Observer{{Contract: contract, ChannelId: "channel-0", Denom: "stake"}}
+		}, true},
+		{"too many observers", func(gs *ibchookstypes.GenesisState) {
+			gs.Params.MaxObservers = 1
+			gs.Observers = []ibchookstypes.Observer{observer, {Contract: suite.EchoContractAddr.String(), Denom: "stake"}}
+		}, true},
+		{"duplicate intermediate sender", func(gs *ibchookstypes.GenesisState) {
+			intermediateSender := ibchookstypes.IntermediateSender{
+				Address: contract,
+				Origin:  ibchookstypes.IntermediateSenderOrigin{ChannelId: "channel-0", OriginalSender: "osmo1sender"},
+			}
+			gs.IntermediateSenders = []ibchookstypes.IntermediateSender{intermediateSender, intermediateSender}
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			gs := ibchookstypes.DefaultGenesis()
+			tc.malleate(gs)
+			err := gs.Validate()
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
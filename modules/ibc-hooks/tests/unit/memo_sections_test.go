@@ -0,0 +1,100 @@
+package tests_unit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11"
+	"github.com/cosmos/ibc-apps/modules/memo"
+)
+
+func TestRegisterMemoSections(t *testing.T) {
+	registry := memo.NewRegistry(memo.Config{RejectUnknownKeys: true, RejectDuplicateKeys: true})
+	require.NoError(t, ibc_hooks.RegisterMemoSections(registry))
+	require.Error(t, ibc_hooks.RegisterMemoSections(registry))
+
+	contract := "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
+	testCases := []struct {
+		name   string
+		memo   string
+		expErr bool
+	}{
+		{"wasm", `{"wasm":{"contract":"` + contract + `","msg":{"increment":{}}}}`, false},
+		{"wasm with on_failure", `{"wasm":{"contract":"` + contract + `","msg":{},"on_failure":"keep"}}`, false},
+		{"wasm invalid contract", `{"wasm":{"contract":"invalid","msg":{}}}`, true},
+		{"wasm msg not an object", `{"wasm":{"contract":"` + contract + `","msg":"increment"}}`, true},
+		{"wasm invalid on_failure", `{"wasm":{"contract":"` + contract + `","msg":{},"on_failure":"drop"}}`, true},
+		{"wasm with relayer_fee", `{"wasm":{"contract":"` + contract + `","msg":{},"relayer_fee":"10"}}`, false},
+		{"wasm invalid relayer_fee", `{"wasm":{"contract":"` + contract + `","msg":{},"relayer_fee":"-1"}}`, true},
+		{"callback", `{"ibc_callback":"` + contract + `"}`, false},
+		{"callback not a string", `{"ibc_callback":1}`, true},
+		{"module", `{"module":{"name":"staking","msg":{}}}`, false},
+		{"module without name", `{"module":{"msg":{}}}`, true},
+		{"unknown key", `{"forward":{}}`, true},
+		{"duplicate key", `{"ibc_callback":"` + contract + `","ibc_callback":"` + contract + `"}`, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := registry.Parse(tc.memo)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseMemoSections(t *testing.T) {
+	contract := "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
+
+	// memos that aren't JSON objects aren't routed to the hooks
+	for _, notRouted := range []string{"", "hello", `["wasm"]`, `{"wasm":`, `{"forward":{}}`} {
+		isWasmRouted, _, _, err := ibc_hooks.ValidateAndParseMemo(notRouted, contract)
+		require.False(t, isWasmRouted, notRouted)
+		require.NoError(t, err, notRouted)
+		isCallbackRouted, _, err := ibc_hooks.ParseCallbackMemo(notRouted)
+		require.False(t, isCallbackRouted, notRouted)
+		require.NoError(t, err, notRouted)
+	}
+
+	wasmMemo := `{"wasm": {"contract": "` + contract + `", "msg": {"increment": {}}, "on_failure": "keep", "relayer_fee": "10"}, "ibc_callback": "` + contract + `"}`
+	isWasmRouted, contractAddr, msgBytes, err := ibc_hooks.ValidateAndParseMemo(wasmMemo, contract)
+	require.True(t, isWasmRouted)
+	require.NoError(t, err)
+	require.Equal(t, contract, contractAddr.String())
+	require.JSONEq(t, `{"increment": {}}`, string(msgBytes))
+
+	keepOnFailure, err := ibc_hooks.ParseOnFailure(wasmMemo)
+	require.NoError(t, err)
+	require.True(t, keepOnFailure)
+	fee, found, err := ibc_hooks.ParseRelayerFee(wasmMemo)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(10), fee.Int64())
+	isCallbackRouted, callback, err := ibc_hooks.ParseCallbackMemo(wasmMemo)
+	require.True(t, isCallbackRouted)
+	require.NoError(t, err)
+	require.Equal(t, contract, callback)
+
+	// the contract must be the receiver of the packet
+	isWasmRouted, _, _, err = ibc_hooks.ValidateAndParseMemo(wasmMemo, "receiver")
+	require.True(t, isWasmRouted)
+	require.Error(t, err)
+
+	// sections of the wrong type are routed and rejected
+	isWasmRouted, _, _, err = ibc_hooks.ValidateAndParseMemo(`{"wasm": "increment"}`, contract)
+	require.True(t, isWasmRouted)
+	require.Error(t, err)
+	isWasmRouted, _, _, err = ibc_hooks.ValidateAndParseMemo(`{"wasm": {"contract": "`+contract+`", "msg": null}}`, contract)
+	require.True(t, isWasmRouted)
+	require.Error(t, err)
+
+	isModuleRouted, moduleName, msgBytes, err := ibc_hooks.ValidateAndParseModuleMemo(`{"module": {"name": "bank", "msg": {"@type": "/cosmos.bank.v1beta1.MsgSend"}}}`)
+	require.True(t, isModuleRouted)
+	require.NoError(t, err)
+	require.Equal(t, "bank", moduleName)
+	require.JSONEq(t, `{"@type": "/cosmos.bank.v1beta1.MsgSend"}`, string(msgBytes))
+}
@@ -0,0 +1,144 @@
+package tests_unit
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11"
+	ibchookskeeper "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/keeper"
+	ibctransfer "github.com/cosmos/ibc-go/v11/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+)
+
+// setupModuleHooks escrows funds for the received packets and returns the module hooks middleware around the
+// transfer module together with the intermediate sender of the test address.
+func (suite *HooksTestSuite) setupModuleHooks() (ibc_hooks.IBCMiddleware, string) {
+	suite.SetupEnv()
+
+	escrowAddress := transfertypes.GetEscrowAddress("", "")
+	testEscrowAmount := sdk.NewInt64Coin("stake", 10)
+	err := suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAddress.GetAddress(), escrowAddress, sdk.NewCoins(testEscrowAmount))
+	suite.Require().NoError(err)
+	if transferKeeper, ok := any(suite.App.TransferKeeper).(TransferKeeperWithTotalEscrowTracking); ok {
+		transferKeeper.SetTotalEscrowForDenom(suite.Ctx, testEscrowAmount)
+	}
+
+	moduleHooks := ibc_hooks.NewModuleHooks(suite.App.AppCodec(), suite.App.MsgServiceRouter(), "cosmos")
+	moduleHooks.RegisterModule("bank", sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	ics4Middleware := ibc_hooks.NewICS4Middleware(suite.App.IBCKeeper.ChannelKeeper, moduleHooks)
+	ibcmiddleware := ibc_hooks.NewIBCMiddleware(ibctransfer.NewIBCModule(suite.App.TransferKeeper), &ics4Middleware)
+
+	intermediateSender, err := ibchookskeeper.DeriveIntermediateSender("", suite.TestAddress.GetAddress().String(), "cosmos")
+	suite.Require().NoError(err)
+	return ibcmiddleware, intermediateSender
+}
+
+func (suite *HooksTestSuite) modulePacket(memo string) channeltypes.Packet {
+	return channeltypes.Packet{
+		Data: transfertypes.FungibleTokenPacketData{
+			Denom:    testDenom,
+			Amount:   "1",
+			Sender:   suite.TestAddress.GetAddress().String(),
+			Receiver: suite.TestAddress.GetAddress().String(),
+			Memo:     memo,
+		}.GetBytes(),
+		SourcePort:    testSourcePort,
+		SourceChannel: testSourceChannel,
+	}
+}
+
+func bankSendMemo(from, to string, amount int64) string {
+	return fmt.Sprintf(
+		`{"module":{"name":"bank","msg":{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":%q,"to_address":%q,"amount":[{"denom":"stake","amount":"%d"}]}}}`,
+		from, to, amount,
+	)
+}
+
+func (suite *HooksTestSuite) TestOnRecvPacketModuleHook() {
+	ibcmiddleware, intermediateSender := suite.setupModuleHooks()
+	receiver := suite.TestAddress.GetAddress().String()
+
+	// an allowed msg is executed by the intermediate sender and its events are emitted
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	res := ibcmiddleware.OnRecvPacket(ctx, transfertypes.V1, suite.modulePacket(bankSendMemo(intermediateSender, receiver, 1)), suite.TestAddress.GetAddress())
+	suite.Require().True(res.Success())
+
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(json.Unmarshal(res.Acknowledgement(), &ack))
+	var moduleAck ibc_hooks.ModuleAck
+	suite.Require().NoError(json.Unmarshal(ack.GetResult(), &moduleAck))
+	suite.NotEmpty(moduleAck.IbcAck)
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != banktypes.EventTypeTransfer {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == banktypes.AttributeKeySender && attr.Value == intermediateSender {
+				found = true
+			}
+		}
+	}
+	suite.True(found, "the transfer event of the module msg should be emitted")
+
+	// msgs that aren't allowed for the module are rejected
+	setSendEnabled := fmt.Sprintf(`{"module":{"name":"bank","msg":{"@type":"/cosmos.bank.v1beta1.MsgSetSendEnabled","authority":%q}}}`, intermediateSender)
+	res = ibcmiddleware.OnRecvPacket(suite.Ctx, transfertypes.V1, suite.modulePacket(setSendEnabled), suite.TestAddress.GetAddress())
+	suite.False(res.Success())
+
+	// unknown modules are rejected
+	unknownModule := `{"module":{"name":"staking","msg":{"@type":"/cosmos.bank.v1beta1.MsgSend"}}}`
+	res = ibcmiddleware.OnRecvPacket(suite.Ctx, transfertypes.V1, suite.modulePacket(unknownModule), suite.TestAddress.GetAddress())
+	suite.False(res.Success())
+
+	// msgs signed by someone else than the intermediate sender are rejected
+	res = ibcmiddleware.OnRecvPacket(suite.Ctx, transfertypes.V1, suite.modulePacket(bankSendMemo(receiver, receiver, 1)), suite.TestAddress.GetAddress())
+	suite.False(res.Success())
+
+	// msgs that fail to execute return an error ack
+	res = ibcmiddleware.OnRecvPacket(suite.Ctx, transfertypes.V1, suite.modulePacket(bankSendMemo(intermediateSender, receiver, 1000)), suite.TestAddress.GetAddress())
+	suite.False(res.Success())
+
+	// a memo can't be routed to both hooks
+	both := fmt.Sprintf(`{"wasm":{"contract":%q,"msg":{}},%s`, suite.EchoContractAddr.String(), bankSendMemo(intermediateSender, receiver, 1)[1:])
+	res = ibcmiddleware.OnRecvPacket(suite.Ctx, transfertypes.V1, suite.modulePacket(both), suite.TestAddress.GetAddress())
+	suite.False(res.Success())
+}
+
+func (suite *HooksTestSuite) TestOnRecvPacketModuleHookStackedWithWasm() {
+	moduleMiddleware, intermediateSender := suite.setupModuleHooks()
+
+	// the module hooks middleware is nested inside the wasm hooks middleware
+	suite.setupWasmHooks(suite.App.IBCKeeper.ChannelKeeper, &moduleMiddleware)
+
+	// module memos go through the wasm hooks untouched
+	res := suite.IBCMiddleware.OnRecvPacket(suite.Ctx, transfertypes.V1, suite.modulePacket(bankSendMemo(intermediateSender, suite.TestAddress.GetAddress().String(), 1)), suite.TestAddress.GetAddress())
+	suite.Require().True(res.Success())
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(json.Unmarshal(res.Acknowledgement(), &ack))
+	var moduleAck ibc_hooks.ModuleAck
+	suite.Require().NoError(json.Unmarshal(ack.GetResult(), &moduleAck))
+
+	// wasm memos are executed by the wasm hooks and ignored by the module hooks
+	wasmPacket := channeltypes.Packet{
+		Data: transfertypes.FungibleTokenPacketData{
+			Denom:    testDenom,
+			Amount:   "1",
+			Sender:   suite.TestAddress.GetAddress().String(),
+			Receiver: suite.EchoContractAddr.String(),
+			Memo:     fmt.Sprintf(`{"wasm":{"contract": "%s", "msg":{"echo":{"msg":"test"}}}}`, suite.EchoContractAddr.String()),
+		}.GetBytes(),
+		SourcePort:    testSourcePort,
+		SourceChannel: testSourceChannel,
+	}
+	res = suite.IBCMiddleware.OnRecvPacket(suite.Ctx, transfertypes.V1, wasmPacket, suite.TestAddress.GetAddress())
+	suite.Require().True(res.Success())
+	suite.Require().NoError(json.Unmarshal(res.Acknowledgement(), &ack))
+	var contractAck map[string]json.RawMessage
+	suite.Require().NoError(json.Unmarshal(ack.GetResult(), &contractAck))
+	suite.Contains(contractAck, "contract_result")
+}
@@ -4,21 +4,27 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	_ "embed"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11"
 	ibchookskeeper "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/keeper"
 	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/simapp"
 	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/tests/unit/mocks"
+	ibchookstypes "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
 	ibctransfer "github.com/cosmos/ibc-go/v11/modules/apps/transfer"
 	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v11/modules/core/04-channel/v2/types"
+	porttypes "github.com/cosmos/ibc-go/v11/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v11/modules/core/exported"
 	ibcmock "github.com/cosmos/ibc-go/v11/testing/mock"
 )
 
@@ -36,6 +42,11 @@ type HooksTestSuite struct {
 	EchoContractAddr    sdk.AccAddress
 	CounterContractAddr sdk.AccAddress
 	TestAddress         *types.BaseAccount
+
+	// the wasm hooks of the app and the middlewares using them, around the transfer module by default
+	WasmHooks      ibc_hooks.WasmHooks
+	ICS4Middleware ibc_hooks.ICS4Middleware
+	IBCMiddleware  ibc_hooks.IBCMiddleware
 }
 
 func TestIBCHooksTestSuite(t *testing.T) {
@@ -81,6 +92,15 @@ func (suite *HooksTestSuite) SetupEnv() {
 	suite.EchoContractAddr = echoContractAddr
 	suite.CounterContractAddr = counterContractAddr
 	suite.TestAddress = acc
+	suite.setupWasmHooks(app.IBCKeeper.ChannelKeeper, ibctransfer.NewIBCModule(app.TransferKeeper))
+}
+
+// setupWasmHooks creates the wasm hooks of the app, and the ICS4 and IBC middlewares using them around the ICS4
+// wrapper and the IBC application
+func (suite *HooksTestSuite) setupWasmHooks(ics4Wrapper porttypes.ICS4Wrapper, app porttypes.IBCModule) {
+	suite.WasmHooks = ibc_hooks.NewWasmHooks(&suite.App.IBCHooksKeeper, &suite.App.WasmKeeper, "cosmos")
+	suite.ICS4Middleware = ibc_hooks.NewICS4Middleware(ics4Wrapper, suite.WasmHooks)
+	suite.IBCMiddleware = ibc_hooks.NewIBCMiddleware(app, &suite.ICS4Middleware)
 }
 
 const (
@@ -117,28 +137,8 @@ func (suite *HooksTestSuite) TestOnRecvPacketEcho() {
 		transferKeeper.SetTotalEscrowForDenom(suite.Ctx, testEscrowAmount)
 	}
 
-	// create the wasm hooks
-	wasmHooks := ibc_hooks.NewWasmHooks(
-		&suite.App.IBCHooksKeeper,
-		&suite.App.WasmKeeper,
-		"cosmos",
-	)
-
-	// create the ics4 middleware
-	ics4Middleware := ibc_hooks.NewICS4Middleware(
-		suite.App.IBCKeeper.ChannelKeeper,
-		wasmHooks,
-	)
-
-	// create the ibc middleware
-	transferIBCModule := ibctransfer.NewIBCModule(suite.App.TransferKeeper)
-	ibcmiddleware := ibc_hooks.NewIBCMiddleware(
-		transferIBCModule,
-		&ics4Middleware,
-	)
-
 	// call the hook twice
-	res := ibcmiddleware.OnRecvPacket(
+	res := suite.IBCMiddleware.OnRecvPacket(
 		suite.Ctx,
 		transfertypes.V1,
 		recvPacket,
@@ -150,6 +150,151 @@ func (suite *HooksTestSuite) TestOnRecvPacketEcho() {
 	suite.Require().NoError(err)
 	suite.Require().NotContains(ack, "error")
 	suite.Require().Equal(ack["result"], "eyJjb250cmFjdF9yZXN1bHQiOiJkR2hwY3lCemFHOTFiR1FnWldOb2J3PT0iLCJpYmNfYWNrIjoiZXlKeVpYTjFiSFFpT2lKQlVUMDlJbjA9In0=")
+
+	// the intermediate sender can be derived with the query and its origin is recorded
+	senderRes, err := suite.App.IBCHooksKeeper.IntermediateSender(suite.Ctx, &ibchookstypes.QueryIntermediateSenderRequest{
+		ChannelId:      recvPacket.GetDestChannel(),
+		OriginalSender: suite.TestAddress.GetAddress().String(),
+		Prefix:         "osmo",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Contains(senderRes.Address, "osmo1")
+
+	originRes, err := suite.App.IBCHooksKeeper.IntermediateSenderOrigin(suite.Ctx, &ibchookstypes.QueryIntermediateSenderOriginRequest{
+		Address: senderRes.Address,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(recvPacket.GetDestChannel(), originRes.Origin.ChannelId)
+	suite.Require().Equal(suite.TestAddress.GetAddress().String(), originRes.Origin.OriginalSender)
+}
+
+func (suite *HooksTestSuite) TestOnRecvPacketKeepOnFailure() {
+	suite.SetupEnv()
+
+	// escrow funds for both packets
+	escrowAddress := transfertypes.GetEscrowAddress("", "")
+	testEscrowAmount := sdk.NewInt64Coin("stake", 2)
+	err := suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAddress.GetAddress(), escrowAddress, sdk.NewCoins(testEscrowAmount))
+	suite.NoError(err)
+	if transferKeeper, ok := any(suite.App.TransferKeeper).(TransferKeeperWithTotalEscrowTracking); ok {
+		transferKeeper.SetTotalEscrowForDenom(suite.Ctx, testEscrowAmount)
+	}
+
+	recvPacket := func(onFailure string) channeltypes.Packet {
+		return channeltypes.Packet{
+			Data: transfertypes.FungibleTokenPacketData{
+				Denom:    testDenom,
+				Amount:   "1",
+				Sender:   suite.TestAddress.GetAddress().String(),
+				Receiver: suite.EchoContractAddr.String(),
+				Memo:     fmt.Sprintf(`{"wasm":{"contract": "%s", "msg":{"unknown":{}}, "on_failure": %q}}`, suite.EchoContractAddr.String(), onFailure),
+			}.GetBytes(),
+			SourcePort:    testSourcePort,
+			SourceChannel: testSourceChannel,
+		}
+	}
+
+	// the default mode returns an error ack
+	res := suite.IBCMiddleware.OnRecvPacket(suite.Ctx, transfertypes.V1, recvPacket(ibchookstypes.OnFailureRevert), suite.TestAddress.GetAddress())
+	suite.False(res.Success())
+
+	// invalid modes are rejected
+	res = suite.IBCMiddleware.OnRecvPacket(suite.Ctx, transfertypes.V1, recvPacket("invalid"), suite.TestAddress.GetAddress())
+	suite.False(res.Success())
+
+	// the keep mode acks successfully and keeps the funds in the intermediate sender
+	res = suite.IBCMiddleware.OnRecvPacket(suite.Ctx, transfertypes.V1, recvPacket(ibchookstypes.OnFailureKeep), suite.TestAddress.GetAddress())
+	suite.Require().True(res.Success())
+
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(json.Unmarshal(res.Acknowledgement(), &ack))
+	var recoverableAck ibc_hooks.RecoverableErrorAck
+	suite.Require().NoError(json.Unmarshal(ack.GetResult(), &recoverableAck))
+	suite.NotEmpty(recoverableAck.ContractError)
+
+	intermediateSender, err := ibchookskeeper.DeriveIntermediateSender("", suite.TestAddress.GetAddress().String(), "cosmos")
+	suite.Require().NoError(err)
+	suite.Equal(intermediateSender, recoverableAck.IntermediateSender)
+
+	queryRes, err := suite.App.IBCHooksKeeper.StrandedFunds(suite.Ctx, &ibchookstypes.QueryStrandedFundsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(queryRes.StrandedBalances, 1)
+	suite.Equal(intermediateSender, queryRes.StrandedBalances[0].StrandedFunds.Address)
+	suite.Equal(suite.TestAddress.GetAddress().String(), queryRes.StrandedBalances[0].StrandedFunds.OriginalSender)
+	suite.False(queryRes.StrandedBalances[0].Balance.IsZero())
+
+	// only the original sender or the authority can recover the funds
+	msgServer := ibchookskeeper.NewMsgServerImpl(suite.App.IBCHooksKeeper)
+	recipient := suite.EchoContractAddr.String()
+	_, err = msgServer.RecoverStrandedFunds(suite.Ctx, ibchookstypes.NewMsgRecoverStrandedFunds(recipient, intermediateSender, recipient))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the funds are sent to the recipient and the record is deleted
+	recipientBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, suite.EchoContractAddr)
+	recoverRes, err := msgServer.RecoverStrandedFunds(suite.Ctx, ibchookstypes.NewMsgRecoverStrandedFunds(suite.TestAddress.GetAddress().String(), intermediateSender, recipient))
+	suite.Require().NoError(err)
+	suite.Equal(queryRes.StrandedBalances[0].Balance, recoverRes.Amount)
+	suite.Equal(recipientBalance.Add(recoverRes.Amount...), suite.App.BankKeeper.GetAllBalances(suite.Ctx, suite.EchoContractAddr))
+	suite.True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, sdk.MustAccAddressFromBech32(intermediateSender)).IsZero())
+	_, found := suite.App.IBCHooksKeeper.GetStrandedFunds(suite.Ctx, intermediateSender)
+	suite.False(found)
+
+	// and can't be recovered twice
+	_, err = msgServer.RecoverStrandedFunds(suite.Ctx, ibchookstypes.NewMsgRecoverStrandedFunds(suite.TestAddress.GetAddress().String(), intermediateSender, ""))
+	suite.Require().ErrorIs(err, ibchookstypes.ErrNoStrandedFunds)
+}
+
+func (suite *HooksTestSuite) TestRecoverStrandedFundsOfContractSender() {
+	suite.SetupEnv()
+
+	escrowAddress := transfertypes.GetEscrowAddress("", "")
+	testEscrowAmount := sdk.NewInt64Coin("stake", 1)
+	err := suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAddress.GetAddress(), escrowAddress, sdk.NewCoins(testEscrowAmount))
+	suite.NoError(err)
+	if transferKeeper, ok := any(suite.App.TransferKeeper).(TransferKeeperWithTotalEscrowTracking); ok {
+		transferKeeper.SetTotalEscrowForDenom(suite.Ctx, testEscrowAmount)
+	}
+
+	// the funds of a failed execution are kept for a contract of the counterparty, which can't sign on this chain
+	contractSender := sdk.MustBech32ifyAddressBytes("osmo", suite.CounterContractAddr)
+	recvPacket := channeltypes.Packet{
+		Data: transfertypes.FungibleTokenPacketData{
+			Denom:    testDenom,
+			Amount:   "1",
+			Sender:   contractSender,
+			Receiver: suite.EchoContractAddr.String(),
+			Memo:     fmt.Sprintf(`{"wasm":{"contract": "%s", "msg":{"unknown":{}}, "on_failure": %q}}`, suite.EchoContractAddr.String(), ibchookstypes.OnFailureKeep),
+		}.GetBytes(),
+		SourcePort:    testSourcePort,
+		SourceChannel: testSourceChannel,
+	}
+	res := suite.IBCMiddleware.OnRecvPacket(suite.Ctx, transfertypes.V1, recvPacket, suite.TestAddress.GetAddress())
+	suite.Require().True(res.Success())
+
+	intermediateSender, err := ibchookskeeper.DeriveIntermediateSender("", contractSender, "cosmos")
+	suite.Require().NoError(err)
+	strandedFunds, found := suite.App.IBCHooksKeeper.GetStrandedFunds(suite.Ctx, intermediateSender)
+	suite.Require().True(found)
+	suite.Require().Equal(contractSender, strandedFunds.OriginalSender)
+
+	// other signers can't recover the funds
+	msgServer := ibchookskeeper.NewMsgServerImpl(suite.App.IBCHooksKeeper)
+	recipient := suite.TestAddress.GetAddress().String()
+	_, err = msgServer.RecoverStrandedFunds(suite.Ctx, ibchookstypes.NewMsgRecoverStrandedFunds(recipient, intermediateSender, recipient))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the authority recovers them to the recipient it sets
+	authority := suite.App.IBCHooksKeeper.GetAuthority()
+	_, err = msgServer.RecoverStrandedFunds(suite.Ctx, ibchookstypes.NewMsgRecoverStrandedFunds(authority, intermediateSender, ""))
+	suite.Require().ErrorIs(err, ibchookstypes.ErrMsgValidation)
+
+	recipientBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, suite.TestAddress.GetAddress())
+	recoverRes, err := msgServer.RecoverStrandedFunds(suite.Ctx, ibchookstypes.NewMsgRecoverStrandedFunds(authority, intermediateSender, recipient))
+	suite.Require().NoError(err)
+	suite.Require().False(recoverRes.Amount.IsZero())
+	suite.Equal(recipientBalance.Add(recoverRes.Amount...), suite.App.BankKeeper.GetAllBalances(suite.Ctx, suite.TestAddress.GetAddress()))
+	_, found = suite.App.IBCHooksKeeper.GetStrandedFunds(suite.Ctx, intermediateSender)
+	suite.False(found)
 }
 
 func (suite *HooksTestSuite) TestOnRecvPacketCounterContract() {
@@ -180,35 +325,15 @@ func (suite *HooksTestSuite) TestOnRecvPacketCounterContract() {
 		transferKeeper.SetTotalEscrowForDenom(suite.Ctx, testEscrowAmount)
 	}
 
-	// create the wasm hooks
-	wasmHooks := ibc_hooks.NewWasmHooks(
-		&suite.App.IBCHooksKeeper,
-		&suite.App.WasmKeeper,
-		"cosmos",
-	)
-
-	// create the ics4 middleware
-	ics4Middleware := ibc_hooks.NewICS4Middleware(
-		suite.App.IBCKeeper.ChannelKeeper,
-		wasmHooks,
-	)
-
-	// create the ibc middleware
-	transferIBCModule := ibctransfer.NewIBCModule(suite.App.TransferKeeper)
-	ibcmiddleware := ibc_hooks.NewIBCMiddleware(
-		transferIBCModule,
-		&ics4Middleware,
-	)
-
 	// call the hook twice
-	res := ibcmiddleware.OnRecvPacket(
+	res := suite.IBCMiddleware.OnRecvPacket(
 		suite.Ctx,
 		transfertypes.V1,
 		recvPacket,
 		suite.TestAddress.GetAddress(),
 	)
 	suite.True(res.Success())
-	res = ibcmiddleware.OnRecvPacket(
+	res = suite.IBCMiddleware.OnRecvPacket(
 		suite.Ctx,
 		transfertypes.V1,
 		recvPacket,
@@ -260,28 +385,14 @@ func (suite *HooksTestSuite) TestOnAcknowledgementPacketCounterContract() {
 		transferKeeper.SetTotalEscrowForDenom(suite.Ctx, testEscrowAmount)
 	}
 
-	// create the wasm hooks
-	wasmHooks := ibc_hooks.NewWasmHooks(
-		&suite.App.IBCHooksKeeper,
-		&suite.App.WasmKeeper,
-		"cosmos",
-	)
+	// the counter contract allows the test address to use it as a callback
+	suite.App.IBCHooksKeeper.SetCallbackAuthorization(suite.Ctx, suite.CounterContractAddr.String(), suite.TestAddress.GetAddress().String(), true)
 
-	// create the ics4 middleware
-	ics4Middleware := ibc_hooks.NewICS4Middleware(
-		&mocks.ICS4WrapperMock{},
-		wasmHooks,
-	)
-
-	// create the ibc middleware
-	transferIBCModule := ibctransfer.NewIBCModule(suite.App.TransferKeeper)
-	ibcmiddleware := ibc_hooks.NewIBCMiddleware(
-		transferIBCModule,
-		&ics4Middleware,
-	)
+	// the packets are sent to the mock ICS4 wrapper
+	suite.setupWasmHooks(&mocks.ICS4WrapperMock{}, ibctransfer.NewIBCModule(suite.App.TransferKeeper))
 
 	// call the hook
-	seq, err := ibcmiddleware.SendPacket(
+	seq, err := suite.IBCMiddleware.SendPacket(
 		suite.Ctx,
 		callbackPacket.SourcePort,
 		callbackPacket.SourceChannel,
@@ -312,8 +423,8 @@ func (suite *HooksTestSuite) TestOnAcknowledgementPacketCounterContract() {
 		SourceChannel: testSourceChannel,
 	}
 	suite.NoError(err)
-	err = wasmHooks.OnAcknowledgementPacketOverride(
-		ibcmiddleware,
+	err = suite.WasmHooks.OnAcknowledgementPacketOverride(
+		suite.IBCMiddleware,
 		suite.Ctx,
 		transfertypes.V1,
 		recvPacket,
@@ -359,28 +470,14 @@ func (suite *HooksTestSuite) TestOnTimeoutPacketOverrideCounterContract() {
 		transferKeeper.SetTotalEscrowForDenom(suite.Ctx, testEscrowAmount)
 	}
 
-	// create the wasm hooks
-	wasmHooks := ibc_hooks.NewWasmHooks(
-		&suite.App.IBCHooksKeeper,
-		&suite.App.WasmKeeper,
-		"cosmos",
-	)
+	// the counter contract allows the test address to use it as a callback
+	suite.App.IBCHooksKeeper.SetCallbackAuthorization(suite.Ctx, suite.CounterContractAddr.String(), suite.TestAddress.GetAddress().String(), true)
 
-	// create the ics4 middleware
-	ics4Middleware := ibc_hooks.NewICS4Middleware(
-		&mocks.ICS4WrapperMock{},
-		wasmHooks,
-	)
-
-	// create the ibc middleware
-	transferIBCModule := ibctransfer.NewIBCModule(suite.App.TransferKeeper)
-	ibcmiddleware := ibc_hooks.NewIBCMiddleware(
-		transferIBCModule,
-		&ics4Middleware,
-	)
+	// the packets are sent to the mock ICS4 wrapper
+	suite.setupWasmHooks(&mocks.ICS4WrapperMock{}, ibctransfer.NewIBCModule(suite.App.TransferKeeper))
 
 	// call the hook
-	seq, err := ibcmiddleware.SendPacket(
+	seq, err := suite.IBCMiddleware.SendPacket(
 		suite.Ctx,
 		callbackPacket.SourcePort,
 		callbackPacket.SourceChannel,
@@ -411,8 +508,8 @@ func (suite *HooksTestSuite) TestOnTimeoutPacketOverrideCounterContract() {
 		SourceChannel: testSourceChannel,
 	}
 	suite.NoError(err)
-	err = wasmHooks.OnTimeoutPacketOverride(
-		ibcmiddleware,
+	err = suite.WasmHooks.OnTimeoutPacketOverride(
+		suite.IBCMiddleware,
 		suite.Ctx,
 		transfertypes.V1,
 		recvPacket,
@@ -431,6 +528,429 @@ func (suite *HooksTestSuite) TestOnTimeoutPacketOverrideCounterContract() {
 	suite.Equal(`{"count":10}`, string(count))
 }
 
+func (suite *HooksTestSuite) TestSendPacketCallbackValidation() {
+	suite.SetupEnv()
+	suite.setupWasmHooks(&mocks.ICS4WrapperMock{}, ibctransfer.NewIBCModule(suite.App.TransferKeeper))
+
+	sender := suite.TestAddress.GetAddress().String()
+	sendWithMemo := func(memo string) (uint64, error) {
+		data := transfertypes.FungibleTokenPacketData{
+			Denom:    testDenom,
+			Amount:   "1",
+			Sender:   sender,
+			Receiver: suite.CounterContractAddr.String(),
+			Memo:     memo,
+		}.GetBytes()
+		return suite.ICS4Middleware.SendPacket(suite.Ctx, testSourcePort, testSourceChannel, ibcclienttypes.Height{RevisionNumber: 1, RevisionHeight: 1}, 1, data)
+	}
+
+	// malformed callbacks error the tx
+	_, err := sendWithMemo(`{"ibc_callback": 1}`)
+	suite.ErrorIs(err, ibchookstypes.ErrMsgValidation)
+	_, err = sendWithMemo(`{"ibc_callback": "notanaddress"}`)
+	suite.ErrorIs(err, ibchookstypes.ErrMsgValidation)
+
+	// the callback must be an existing contract
+	_, err = sendWithMemo(fmt.Sprintf(`{"ibc_callback": %q}`, sender))
+	suite.ErrorIs(err, ibchookstypes.ErrMsgValidation)
+
+	// the sender must be authorized by the contract
+	_, err = sendWithMemo(fmt.Sprintf(`{"ibc_callback": %q}`, suite.CounterContractAddr))
+	suite.ErrorIs(err, ibchookstypes.ErrUnauthorizedCallback)
+	suite.Empty(suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, testSourceChannel, 1))
+
+	suite.App.IBCHooksKeeper.SetCallbackAuthorization(suite.Ctx, suite.CounterContractAddr.String(), sender, true)
+	seq, err := sendWithMemo(fmt.Sprintf(`{"ibc_callback": %q}`, suite.CounterContractAddr))
+	suite.NoError(err)
+	suite.Equal(uint64(1), seq)
+	suite.Equal(suite.CounterContractAddr.String(), suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, testSourceChannel, seq))
+}
+
+func (suite *HooksTestSuite) TestSendPacketGuards() {
+	suite.SetupEnv()
+	suite.setupWasmHooks(&mocks.ICS4WrapperMock{}, ibctransfer.NewIBCModule(suite.App.TransferKeeper))
+
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    "stake",
+		Amount:   "1",
+		Sender:   suite.TestAddress.GetAddress().String(),
+		Receiver: suite.EchoContractAddr.String(),
+	}.GetBytes()
+	send := func() (uint64, error) {
+		return suite.ICS4Middleware.SendPacket(suite.Ctx, testSourcePort, testSourceChannel, ibcclienttypes.Height{RevisionNumber: 1, RevisionHeight: 1}, 1, data)
+	}
+
+	// without guards the packet is sent
+	seq, err := send()
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), seq)
+
+	// the counter contract doesn't implement the send guard sudo, so every packet is rejected
+	params := ibchookstypes.DefaultParams()
+	params.SendGuards = []string{suite.CounterContractAddr.String()}
+	err = suite.App.IBCHooksKeeper.SetParams(suite.Ctx, params)
+	suite.Require().NoError(err)
+	_, err = send()
+	suite.Require().ErrorIs(err, ibchookstypes.ErrSendGuardRejected)
+
+	// packets that aren't valid transfers can't bypass the guards
+	invalidData := transfertypes.FungibleTokenPacketData{
+		Denom:    "stake",
+		Amount:   "0",
+		Sender:   suite.TestAddress.GetAddress().String(),
+		Receiver: suite.EchoContractAddr.String(),
+	}.GetBytes()
+	_, err = suite.ICS4Middleware.SendPacket(suite.Ctx, testSourcePort, testSourceChannel, ibcclienttypes.Height{RevisionNumber: 1, RevisionHeight: 1}, 1, invalidData)
+	suite.Require().ErrorIs(err, ibchookstypes.ErrSendGuardRejected)
+	suite.Require().ErrorContains(err, "invalid packet data")
+
+	// other JSON packets, e.g. interchain accounts packets, aren't guarded
+	icaData := []byte(`{"type":"TYPE_EXECUTE_TX","data":"AQ==","memo":""}`)
+	_, err = suite.ICS4Middleware.SendPacket(suite.Ctx, testSourcePort, testSourceChannel, ibcclienttypes.Height{RevisionNumber: 1, RevisionHeight: 1}, 1, icaData)
+	suite.Require().NoError(err)
+
+	// invalid guards can't be set
+	params.SendGuards = []string{"invalid"}
+	err = suite.App.IBCHooksKeeper.SetParams(suite.Ctx, params)
+	suite.Require().Error(err)
+}
+
+func (suite *HooksTestSuite) TestMigrate1to2() {
+	suite.SetupEnv()
+	keeper := suite.App.IBCHooksKeeper
+
+	// the parameters didn't exist in the consensus version 1
+	store := suite.Ctx.KVStore(suite.App.GetKey(ibchookstypes.StoreKey))
+	store.Delete([]byte(ibchookstypes.ParamsKey))
+	suite.Require().Equal(ibchookstypes.Params{}, keeper.GetParams(suite.Ctx))
+
+	migrator := ibchookskeeper.NewMigrator(&keeper)
+	suite.Require().NoError(migrator.Migrate1to2(suite.Ctx))
+	suite.Require().Equal(ibchookstypes.DefaultParams(), keeper.GetParams(suite.Ctx))
+	suite.Require().Empty(keeper.GetSendGuards(suite.Ctx))
+}
+
+func (suite *HooksTestSuite) TestOnRecvPacketRelayerFee() {
+	suite.SetupEnv()
+
+	// escrow funds for all the packets, as the unit test doesn't revert the receive of failed packets
+	escrowAddress := transfertypes.GetEscrowAddress("", "")
+	testEscrowAmount := sdk.NewInt64Coin("stake", 300)
+	err := suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAddress.GetAddress(), escrowAddress, sdk.NewCoins(testEscrowAmount))
+	suite.Require().NoError(err)
+	if transferKeeper, ok := any(suite.App.TransferKeeper).(TransferKeeperWithTotalEscrowTracking); ok {
+		transferKeeper.SetTotalEscrowForDenom(suite.Ctx, testEscrowAmount)
+	}
+
+	relayer := sdk.AccAddress([]byte("relayer_____________"))
+	recvPacket := func(relayerFee string) channeltypes.Packet {
+		return channeltypes.Packet{
+			Data: transfertypes.FungibleTokenPacketData{
+				Denom:    testDenom,
+				Amount:   "100",
+				Sender:   suite.TestAddress.GetAddress().String(),
+				Receiver: suite.EchoContractAddr.String(),
+				Memo:     fmt.Sprintf(`{"wasm":{"contract": "%s", "msg":{"echo":{"msg":"test"}}, "relayer_fee": %q}}`, suite.EchoContractAddr.String(), relayerFee),
+			}.GetBytes(),
+			SourcePort:    testSourcePort,
+			SourceChannel: testSourceChannel,
+		}
+	}
+
+	// relayer fees are disabled by default
+	res := suite.IBCMiddleware.OnRecvPacket(suite.Ctx, transfertypes.V1, recvPacket("10"), relayer)
+	suite.Require().False(res.Success())
+
+	// the fee can't be more than the maximum set by governance
+	params := ibchookstypes.DefaultParams()
+	params.MaxRelayerFeeBps = 1000
+	suite.Require().NoError(suite.App.IBCHooksKeeper.SetParams(suite.Ctx, params))
+	res = suite.IBCMiddleware.OnRecvPacket(suite.Ctx, transfertypes.V1, recvPacket("11"), relayer)
+	suite.Require().False(res.Success())
+
+	// the fee is paid to the relayer and reported in the ack
+	res = suite.IBCMiddleware.OnRecvPacket(suite.Ctx, transfertypes.V1, recvPacket("10"), relayer)
+	suite.Require().True(res.Success())
+
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(json.Unmarshal(res.Acknowledgement(), &ack))
+	var contractAck ibc_hooks.ContractAck
+	suite.Require().NoError(json.Unmarshal(ack.GetResult(), &contractAck))
+	suite.Require().Equal("10stake", contractAck.RelayerFee)
+	suite.Require().Equal(sdk.NewInt64Coin("stake", 10), suite.App.BankKeeper.GetBalance(suite.Ctx, relayer, "stake"))
+}
+
+func (suite *HooksTestSuite) TestObserverSubscriptions() {
+	suite.SetupEnv()
+	msgServer := ibchookskeeper.NewMsgServerImpl(suite.App.IBCHooksKeeper)
+
+	// exactly one of the channel and the denom must be set
+	_, err := msgServer.SubscribeTransfers(suite.Ctx, &ibchookstypes.MsgSubscribeTransfers{
+		Sender: suite.CounterContractAddr.String(),
+	})
+	suite.Require().ErrorIs(err, ibchookstypes.ErrMsgValidation)
+	_, err = msgServer.SubscribeTransfers(suite.Ctx, &ibchookstypes.MsgSubscribeTransfers{
+		Sender:    suite.CounterContractAddr.String(),
+		ChannelId: "channel-0",
+		Denom:     "stake",
+	})
+	suite.Require().ErrorIs(err, ibchookstypes.ErrMsgValidation)
+
+	// only contracts can subscribe
+	_, err = msgServer.SubscribeTransfers(suite.Ctx, &ibchookstypes.MsgSubscribeTransfers{
+		Sender: suite.TestAddress.GetAddress().String(),
+		Denom:  "stake",
+	})
+	suite.Require().ErrorIs(err, ibchookstypes.ErrBadSender)
+
+	// the number of observers is limited
+	params := ibchookstypes.DefaultParams()
+	params.MaxObservers = 1
+	err = suite.App.IBCHooksKeeper.SetParams(suite.Ctx, params)
+	suite.Require().NoError(err)
+	_, err = msgServer.SubscribeTransfers(suite.Ctx, &ibchookstypes.MsgSubscribeTransfers{
+		Sender: suite.CounterContractAddr.String(),
+		Denom:  "stake",
+	})
+	suite.Require().NoError(err)
+	_, err = msgServer.SubscribeTransfers(suite.Ctx, &ibchookstypes.MsgSubscribeTransfers{
+		Sender: suite.EchoContractAddr.String(),
+		Denom:  "stake",
+	})
+	suite.Require().ErrorIs(err, ibchookstypes.ErrTooManyObservers)
+	suite.Require().Equal([]string{suite.CounterContractAddr.String()}, suite.App.IBCHooksKeeper.GetObservers(suite.Ctx, "channel-0", "stake"))
+
+	// the counter contract doesn't implement the notification, which doesn't affect the acknowledgement
+	escrowAddress := transfertypes.GetEscrowAddress("", "")
+	testEscrowAmount := sdk.NewInt64Coin("stake", 1)
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAddress.GetAddress(), escrowAddress, sdk.NewCoins(testEscrowAmount))
+	suite.Require().NoError(err)
+	if transferKeeper, ok := any(suite.App.TransferKeeper).(TransferKeeperWithTotalEscrowTracking); ok {
+		transferKeeper.SetTotalEscrowForDenom(suite.Ctx, testEscrowAmount)
+	}
+
+	ics4Middleware := ibc_hooks.NewICS4Middleware(
+		suite.App.IBCKeeper.ChannelKeeper,
+		ibc_hooks.NewObserverHooks(&suite.App.IBCHooksKeeper, &suite.App.WasmKeeper),
+	)
+	ibcmiddleware := ibc_hooks.NewIBCMiddleware(ibctransfer.NewIBCModule(suite.App.TransferKeeper), &ics4Middleware)
+	recvPacket := channeltypes.Packet{
+		Data: transfertypes.FungibleTokenPacketData{
+			Denom:    testDenom,
+			Amount:   "1",
+			Sender:   suite.TestAddress.GetAddress().String(),
+			Receiver: suite.TestAddress.GetAddress().String(),
+		}.GetBytes(),
+		SourcePort:    testSourcePort,
+		SourceChannel: testSourceChannel,
+	}
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	res := ibcmiddleware.OnRecvPacket(ctx, transfertypes.V1, recvPacket, suite.TestAddress.GetAddress())
+	suite.Require().True(res.Success())
+
+	var observerError bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "ibc-observer-error" {
+			observerError = true
+		}
+	}
+	suite.Require().True(observerError)
+
+	// unsubscribed observers aren't notified anymore
+	_, err = msgServer.UnsubscribeTransfers(suite.Ctx, &ibchookstypes.MsgUnsubscribeTransfers{
+		Sender: suite.CounterContractAddr.String(),
+		Denom:  "stake",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.App.IBCHooksKeeper.GetObservers(suite.Ctx, "channel-0", "stake"))
+}
+
+func (suite *HooksTestSuite) TestMigrate2to3() {
+	suite.SetupEnv()
+	keeper := suite.App.IBCHooksKeeper
+
+	// callbacks of the consensus version 2, next to other keys of the module
+	store := suite.Ctx.KVStore(suite.App.GetKey(ibchookstypes.StoreKey))
+	store.Set([]byte("channel-0::1"), []byte(suite.CounterContractAddr.String()))
+	store.Set([]byte("07-tendermint-0::2"), []byte(suite.EchoContractAddr.String()))
+	keeper.SetCallbackAuthorization(suite.Ctx, suite.CounterContractAddr.String(), suite.TestAddress.GetAddress().String(), true)
+	keeper.StorePendingAcknowledgement(suite.Ctx, channeltypes.Packet{DestinationChannel: "channel-0", Sequence: 3}, suite.EchoContractAddr.String())
+
+	migrator := ibchookskeeper.NewMigrator(&keeper)
+	suite.Require().NoError(migrator.Migrate2to3(suite.Ctx))
+
+	suite.Require().False(store.Has([]byte("channel-0::1")))
+	suite.Require().False(store.Has([]byte("07-tendermint-0::2")))
+	record, found := keeper.GetPacketCallbackRecord(suite.Ctx, "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.CounterContractAddr.String(), record.Contract)
+	suite.Require().Equal(transfertypes.PortID, record.PortId)
+	suite.Require().Zero(record.TimeoutTimestamp)
+	suite.Require().Equal(suite.Ctx.BlockHeight(), record.RegisteredHeight)
+
+	// the callback of the IBC v2 packet expires with the latest timeout its packet could have
+	clientRecord, found := keeper.GetPacketCallbackRecord(suite.Ctx, "07-tendermint-0", 2)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.EchoContractAddr.String(), clientRecord.Contract)
+	suite.Require().Equal(uint64(suite.Ctx.BlockTime().Add(channeltypesv2.MaxTimeoutDelta).UnixNano()), clientRecord.TimeoutTimestamp)
+
+	// the other keys are left untouched
+	suite.Require().True(keeper.IsCallbackAuthorized(suite.Ctx, suite.CounterContractAddr.String(), suite.TestAddress.GetAddress().String()))
+	_, _, found = keeper.GetPendingAcknowledgement(suite.Ctx, "channel-0", 3)
+	suite.Require().True(found)
+
+	// the callbacks are indexed by contract
+	records, err := keeper.GetPacketCallbacksByContract(suite.Ctx, suite.CounterContractAddr.String())
+	suite.Require().NoError(err)
+	suite.Require().Equal([]ibchookstypes.PacketCallbackRecord{record}, records)
+
+	res, err := keeper.PacketCallbacks(suite.Ctx, &ibchookstypes.QueryPacketCallbacksRequest{Contract: suite.EchoContractAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Callbacks, 1)
+	suite.Require().Equal(uint64(2), res.Callbacks[0].Sequence)
+
+	suite.Require().NoError(keeper.DeletePacketCallback(suite.Ctx, "07-tendermint-0", 2))
+	records, err = keeper.GetPacketCallbacksByContract(suite.Ctx, suite.EchoContractAddr.String())
+	suite.Require().NoError(err)
+	suite.Require().Empty(records)
+}
+
+func (suite *HooksTestSuite) TestMigrate3to4() {
+	suite.SetupEnv()
+	keeper := suite.App.IBCHooksKeeper
+
+	// the callback expiry parameters didn't exist in the consensus version 3
+	params := ibchookstypes.DefaultParams()
+	params.MaxObservers = 1
+	params.CallbackExpiryDelay = 0
+	params.MaxCallbacksCheckedPerBlock = 0
+	params.ExpiredCallbackGasLimit = 0
+	suite.Require().NoError(keeper.SetParams(suite.Ctx, params))
+
+	migrator := ibchookskeeper.NewMigrator(&keeper)
+	suite.Require().NoError(migrator.Migrate3to4(suite.Ctx))
+
+	expected := ibchookstypes.DefaultParams()
+	expected.MaxObservers = 1
+	suite.Require().Equal(expected, keeper.GetParams(suite.Ctx))
+}
+
+func (suite *HooksTestSuite) TestMigratedCallbacksExpire() {
+	suite.SetupEnv()
+	keeper := suite.App.IBCHooksKeeper
+
+	// a callback of the consensus version 2 on a transfer channel closed after the migration
+	store := suite.Ctx.KVStore(suite.App.GetKey(ibchookstypes.StoreKey))
+	store.Set([]byte("channel-9::1"), []byte(suite.CounterContractAddr.String()))
+	suite.App.IBCKeeper.ChannelKeeper.SetChannel(suite.Ctx, transfertypes.PortID, "channel-9", channeltypes.Channel{State: channeltypes.OPEN})
+
+	migrator := ibchookskeeper.NewMigrator(&keeper)
+	suite.Require().NoError(migrator.Migrate2to3(suite.Ctx))
+	suite.Require().NoError(migrator.Migrate3to4(suite.Ctx))
+	params := keeper.GetParams(suite.Ctx)
+
+	// the callback doesn't expire while its channel is open
+	now := suite.Ctx.BlockTime()
+	ctx := suite.Ctx.WithBlockTime(now.Add(params.CallbackExpiryDelay + time.Minute))
+	suite.Require().NoError(keeper.EndBlocker(ctx))
+	record, found := keeper.GetPacketCallbackRecord(ctx, "channel-9", 1)
+	suite.Require().True(found)
+	suite.Require().Zero(record.TimeoutTimestamp)
+
+	// the first block seeing the channel closed records its time, and the callback expires after the delay
+	suite.App.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, "channel-9", channeltypes.Channel{State: channeltypes.CLOSED})
+	suite.Require().NoError(keeper.EndBlocker(ctx))
+	record, found = keeper.GetPacketCallbackRecord(ctx, "channel-9", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(ctx.BlockTime().UnixNano()), record.TimeoutTimestamp)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager()).WithBlockTime(ctx.BlockTime().Add(params.CallbackExpiryDelay + time.Minute))
+	suite.Require().NoError(keeper.EndBlocker(ctx))
+	suite.Require().Equal("", keeper.GetPacketCallback(ctx, "channel-9", 1))
+
+	var reasons []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "ibc-hooks-callback-expired" {
+			continue
+		}
+		reason, found := event.GetAttribute("reason")
+		suite.Require().True(found)
+		reasons = append(reasons, reason.Value)
+	}
+	suite.Require().Equal([]string{ibchookskeeper.ExpiryReasonChannelClosed}, reasons)
+}
+
+func (suite *HooksTestSuite) TestEndBlockerPrunesExpiredCallbacks() {
+	suite.SetupEnv()
+	keeper := suite.App.IBCHooksKeeper
+	contract := suite.CounterContractAddr.String()
+
+	params := ibchookstypes.DefaultParams()
+	params.MaxCallbacksCheckedPerBlock = 2
+	suite.Require().NoError(keeper.SetParams(suite.Ctx, params))
+
+	// the localhost client of channel-8 is always at the current height
+	now := suite.Ctx.BlockTime()
+	timeoutHeight := ibcclienttypes.GetSelfHeight(suite.Ctx)
+	suite.App.IBCKeeper.ChannelKeeper.SetChannel(suite.Ctx, "transfer", "channel-8", channeltypes.Channel{
+		State:          channeltypes.OPEN,
+		ConnectionHops: []string{ibcexported.LocalhostConnectionID},
+	})
+	suite.App.IBCKeeper.ChannelKeeper.SetChannel(suite.Ctx, "transfer", "channel-9", channeltypes.Channel{State: channeltypes.CLOSED})
+	for _, record := range []ibchookstypes.PacketCallbackRecord{
+		{Contract: contract, PortId: "transfer", ChannelId: "channel-0", Sequence: 1, TimeoutTimestamp: uint64(now.Add(-params.CallbackExpiryDelay - time.Minute).UnixNano())},
+		{Contract: contract, PortId: "transfer", ChannelId: "channel-0", Sequence: 2, TimeoutTimestamp: uint64(now.Add(-time.Minute).UnixNano())},
+		{Contract: contract, PortId: "transfer", ChannelId: "channel-8", Sequence: 3, TimeoutRevisionNumber: timeoutHeight.RevisionNumber, TimeoutRevisionHeight: timeoutHeight.RevisionHeight},
+		{Contract: contract, PortId: "transfer", ChannelId: "channel-9", Sequence: 4},
+		{Contract: contract, PortId: "transfer", ChannelId: "channel-9", Sequence: 5, TimeoutTimestamp: uint64(now.Add(time.Hour).UnixNano())},
+	} {
+		suite.Require().NoError(keeper.StorePacketCallback(suite.Ctx, record))
+	}
+
+	// the first block checks the two callbacks of channel-0, and only the first one is past the expiry delay
+	suite.Require().NoError(keeper.EndBlocker(suite.Ctx))
+	suite.Require().Equal("", keeper.GetPacketCallback(suite.Ctx, "channel-0", 1))
+	suite.Require().Equal(contract, keeper.GetPacketCallback(suite.Ctx, "channel-0", 2))
+
+	// the next block resumes with the callback past its timeout height and the callback without a timeout on the
+	// closed channel. Their packets can still be timed out, so the block only records when their timeout was seen.
+	suite.Require().NoError(keeper.EndBlocker(suite.Ctx))
+	for _, sequence := range []uint64{3, 4} {
+		channel := "channel-8"
+		if sequence == 4 {
+			channel = "channel-9"
+		}
+		record, found := keeper.GetPacketCallbackRecord(suite.Ctx, channel, sequence)
+		suite.Require().True(found)
+		suite.Require().Equal(uint64(now.UnixNano()), record.TimeoutTimestamp)
+	}
+
+	// once the delay has passed, the callbacks expire, except the one still before its timeout on the closed channel
+	params.MaxCallbacksCheckedPerBlock = 10
+	suite.Require().NoError(keeper.SetParams(suite.Ctx, params))
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager()).WithBlockTime(now.Add(params.CallbackExpiryDelay + time.Minute))
+	suite.Require().NoError(keeper.EndBlocker(ctx))
+	suite.Require().Equal("", keeper.GetPacketCallback(ctx, "channel-0", 2))
+	suite.Require().Equal("", keeper.GetPacketCallback(ctx, "channel-8", 3))
+	suite.Require().Equal("", keeper.GetPacketCallback(ctx, "channel-9", 4))
+	suite.Require().Equal(contract, keeper.GetPacketCallback(ctx, "channel-9", 5))
+
+	var reasons []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "ibc-hooks-callback-expired" {
+			continue
+		}
+		reason, found := event.GetAttribute("reason")
+		suite.Require().True(found)
+		reasons = append(reasons, reason.Value)
+	}
+	suite.Require().Equal([]string{
+		ibchookskeeper.ExpiryReasonTimeout,
+		ibchookskeeper.ExpiryReasonTimeout,
+		ibchookskeeper.ExpiryReasonChannelClosed,
+	}, reasons)
+}
+
 // TransferKeeperWithTotalEscrowTracking defines an interface to check for existing methods
 // in TransferKeeper.
 type TransferKeeperWithTotalEscrowTracking interface {
@@ -0,0 +1,63 @@
+package tests_unit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11"
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+)
+
+func TestParseFundsPacketData(t *testing.T) {
+	packet := channeltypes.Packet{
+		SourcePort:         testSourcePort,
+		SourceChannel:      testSourceChannel,
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-1",
+	}
+	msg := []byte(`{"echo":{"msg":"test"}}`)
+
+	t.Run("ics20 v1", func(t *testing.T) {
+		data := transfertypes.NewFungibleTokenPacketData("uatom", "10", "sender", "receiver", "memo").GetBytes()
+		parsed, ok := ibc_hooks.ParseFundsPacketData(data)
+		require.True(t, ok)
+		require.Equal(t, "receiver", parsed.GetReceiver())
+
+		funds, execMsg, err := parsed.ExecuteParams(packet, msg)
+		require.NoError(t, err)
+		require.Equal(t, msg, execMsg)
+		expectedDenom := transfertypes.NewDenom("uatom", transfertypes.NewHop("transfer", "channel-1")).IBCDenom()
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(expectedDenom, 10)), funds)
+	})
+
+	t.Run("ics721", func(t *testing.T) {
+		data, err := json.Marshal(ibc_hooks.NonFungibleTokenPacketData{
+			ClassID:  "class",
+			TokenIDs: []string{"1", "2"},
+			Sender:   "sender",
+			Receiver: "receiver",
+			Memo:     "memo",
+		})
+		require.NoError(t, err)
+		parsed, ok := ibc_hooks.ParseFundsPacketData(data)
+		require.True(t, ok)
+		require.IsType(t, ibc_hooks.NonFungibleTokenPacketData{}, parsed)
+
+		funds, execMsg, err := parsed.ExecuteParams(packet, msg)
+		require.NoError(t, err)
+		require.True(t, funds.IsZero())
+		var hookMsg ibc_hooks.NFTHookMsg
+		require.NoError(t, json.Unmarshal(execMsg, &hookMsg))
+		require.Equal(t, []string{"1", "2"}, hookMsg.IBCNFTReceived.TokenIDs)
+		require.JSONEq(t, string(msg), string(hookMsg.IBCNFTReceived.Msg))
+	})
+
+	t.Run("unknown packet", func(t *testing.T) {
+		_, ok := ibc_hooks.ParseFundsPacketData([]byte(`{"unknown":"field"}`))
+		require.False(t, ok)
+	})
+}
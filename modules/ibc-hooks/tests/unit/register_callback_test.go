@@ -0,0 +1,91 @@
+package tests_unit
+
+import (
+	"fmt"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11"
+	ibchookskeeper "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/keeper"
+	ibchookstypes "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	ibcmock "github.com/cosmos/ibc-go/v11/testing/mock"
+)
+
+func (suite *HooksTestSuite) TestRegisterPacketCallbackStacks() {
+	suite.SetupEnv()
+
+	// the stacks of the contracts' ports are wrapped with the ibc-hooks middleware
+	for _, port := range []string{
+		wasmkeeper.PortIDForContract(suite.CounterContractAddr),
+		icatypes.ControllerPortPrefix + suite.CounterContractAddr.String(),
+	} {
+		route, found := suite.App.IBCKeeper.PortKeeper.Route(port)
+		suite.Require().True(found, port)
+		_, isHooksMiddleware := route.(*ibc_hooks.IBCMiddleware)
+		suite.Require().True(isHooksMiddleware, port)
+	}
+}
+
+func (suite *HooksTestSuite) TestRegisterPacketCallback() {
+	suite.SetupEnv()
+	msgServer := ibchookskeeper.NewMsgServerImpl(suite.App.IBCHooksKeeper)
+	contract := suite.CounterContractAddr
+	wasmPort := wasmkeeper.PortIDForContract(contract)
+	icaPort := icatypes.ControllerPortPrefix + contract.String()
+
+	// the ibc-hooks middleware around an application that accepts every ack and timeout
+	suite.setupWasmHooks(suite.App.IBCKeeper.ChannelKeeper, ibcmock.NewIBCModule(&ibcmock.AppModule{}, &ibcmock.IBCApp{}))
+
+	register := func(sender, port, channel string, sequence uint64) error {
+		_, err := msgServer.RegisterPacketCallback(suite.Ctx, ibchookstypes.NewMsgRegisterPacketCallback(sender, port, channel, sequence))
+		return err
+	}
+	count := func() string {
+		count, err := suite.App.WasmKeeper.QuerySmart(suite.Ctx, contract, []byte(fmt.Sprintf(`{"get_count":{"addr": %q}}`, contract)))
+		suite.Require().NoError(err)
+		return string(count)
+	}
+
+	// only the packets of the ports owned by the sender can be registered
+	suite.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.Ctx, testSourcePort, "channel-0", 1, []byte("commitment"))
+	suite.Require().ErrorIs(register(contract.String(), testSourcePort, "channel-0", 1), ibchookstypes.ErrUnauthorizedCallback)
+	otherPort := wasmkeeper.PortIDForContract(suite.EchoContractAddr)
+	suite.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.Ctx, otherPort, "channel-1", 1, []byte("commitment"))
+	suite.Require().ErrorIs(register(contract.String(), otherPort, "channel-1", 1), ibchookstypes.ErrUnauthorizedCallback)
+
+	// the packet must be in flight
+	suite.Require().ErrorIs(register(contract.String(), wasmPort, "channel-2", 1), ibchookstypes.ErrPacketNotFound)
+
+	// packets of the contract's wasm port are registered once
+	suite.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.Ctx, wasmPort, "channel-2", 1, []byte("commitment"))
+	suite.Require().NoError(register(contract.String(), wasmPort, "channel-2", 1))
+	suite.Require().ErrorIs(register(contract.String(), wasmPort, "channel-2", 1), ibchookstypes.ErrCallbackExists)
+
+	// the ack is delivered to the contract
+	wasmPacket := channeltypes.Packet{
+		Sequence:      1,
+		SourcePort:    wasmPort,
+		SourceChannel: "channel-2",
+		Data:          ibcmock.MockPacketData,
+	}
+	err := suite.IBCMiddleware.OnAcknowledgementPacket(suite.Ctx, ibcmock.Version, wasmPacket, ibcmock.MockAcknowledgement.Acknowledgement(), suite.TestAddress.GetAddress())
+	suite.Require().NoError(err)
+	suite.Require().Equal(`{"count":1}`, count())
+	suite.Require().Empty(suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, "channel-2", 1))
+
+	// the timeout of a packet sent by an interchain account of the contract is delivered as well
+	suite.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.Ctx, icaPort, "channel-3", 1, []byte("commitment"))
+	suite.Require().NoError(register(contract.String(), icaPort, "channel-3", 1))
+	icaPacket := channeltypes.Packet{
+		Sequence:      1,
+		SourcePort:    icaPort,
+		SourceChannel: "channel-3",
+		Data:          ibcmock.MockPacketData,
+	}
+	err = suite.IBCMiddleware.OnTimeoutPacket(suite.Ctx, ibcmock.Version, icaPacket, suite.TestAddress.GetAddress())
+	suite.Require().NoError(err)
+	suite.Require().Equal(`{"count":11}`, count())
+	suite.Require().Empty(suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, "channel-3", 1))
+}
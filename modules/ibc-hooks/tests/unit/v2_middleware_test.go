@@ -0,0 +1,157 @@
+package tests_unit
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11"
+	ibchookstypes "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+	ibchooksv2 "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/v2"
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	transferv2 "github.com/cosmos/ibc-go/v11/modules/apps/transfer/v2"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v11/modules/core/04-channel/v2/types"
+)
+
+const (
+	testSourceClient      = "07-tendermint-0"
+	testDestinationClient = "07-tendermint-1"
+)
+
+func (suite *HooksTestSuite) v2Middleware() ibchooksv2.IBCMiddleware {
+	observers := ibc_hooks.NewObserverHooks(&suite.App.IBCHooksKeeper, &suite.App.WasmKeeper)
+	return ibchooksv2.NewIBCMiddleware(transferv2.NewIBCModule(suite.App.TransferKeeper), suite.WasmHooks, "cosmos").
+		WithObserverHooks(observers)
+}
+
+func v2Payload(data transfertypes.FungibleTokenPacketData) channeltypesv2.Payload {
+	return channeltypesv2.Payload{
+		SourcePort:      transfertypes.PortID,
+		DestinationPort: transfertypes.PortID,
+		Version:         transfertypes.V1,
+		Encoding:        transfertypes.EncodingJSON,
+		Value:           data.GetBytes(),
+	}
+}
+
+func countObserverErrors(ctx sdk.Context) int {
+	count := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "ibc-observer-error" {
+			count++
+		}
+	}
+	return count
+}
+
+func (suite *HooksTestSuite) TestV2OnRecvPacket() {
+	suite.SetupEnv()
+	middleware := suite.v2Middleware()
+
+	// the packets unwind stake sent over the source client
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, testDestinationClient)
+	testEscrowAmount := sdk.NewInt64Coin("stake", 2)
+	err := suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAddress.GetAddress(), escrowAddress, sdk.NewCoins(testEscrowAmount))
+	suite.Require().NoError(err)
+	if transferKeeper, ok := any(suite.App.TransferKeeper).(TransferKeeperWithTotalEscrowTracking); ok {
+		transferKeeper.SetTotalEscrowForDenom(suite.Ctx, testEscrowAmount)
+	}
+
+	// the counter contract observes stake but doesn't implement the notification
+	suite.Require().NoError(suite.App.IBCHooksKeeper.AddObserver(suite.Ctx, "", "stake", suite.CounterContractAddr.String()))
+
+	packetData := func(receiver, memo string) transfertypes.FungibleTokenPacketData {
+		return transfertypes.FungibleTokenPacketData{
+			Denom:    fmt.Sprintf("%s/%s/stake", transfertypes.PortID, testSourceClient),
+			Amount:   "1",
+			Sender:   suite.TestAddress.GetAddress().String(),
+			Receiver: receiver,
+			Memo:     memo,
+		}
+	}
+	recv := func(ctx sdk.Context, sequence uint64, data transfertypes.FungibleTokenPacketData) channeltypesv2.RecvPacketResult {
+		return middleware.OnRecvPacket(ctx, testSourceClient, testDestinationClient, sequence, v2Payload(data), suite.TestAddress.GetAddress())
+	}
+
+	// the contract is executed with the funds of the packet, and the observers are notified
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	echoMemo := fmt.Sprintf(`{"wasm":{"contract": %q, "msg":{"echo":{"msg":"test"}}}}`, suite.EchoContractAddr.String())
+	res := recv(ctx, 1, packetData(suite.EchoContractAddr.String(), echoMemo))
+	suite.Require().Equal(channeltypesv2.PacketStatus_Success, res.Status)
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(json.Unmarshal(res.Acknowledgement, &ack))
+	var contractAck ibc_hooks.ContractAck
+	suite.Require().NoError(json.Unmarshal(ack.GetResult(), &contractAck))
+	suite.Require().NotEmpty(contractAck.ContractResult)
+	suite.Require().Equal(1, countObserverErrors(ctx))
+
+	// the receiver must be the contract of the memo
+	ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	res = recv(ctx, 2, packetData(suite.TestAddress.GetAddress().String(), echoMemo))
+	suite.Require().Equal(channeltypesv2.PacketStatus_Failure, res.Status)
+	suite.Require().Equal(0, countObserverErrors(ctx))
+
+	// packets without hooks are received by the transfer module and observed as well
+	ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	res = recv(ctx, 3, packetData(suite.TestAddress.GetAddress().String(), ""))
+	suite.Require().Equal(channeltypesv2.PacketStatus_Success, res.Status)
+	suite.Require().Equal(1, countObserverErrors(ctx))
+}
+
+func (suite *HooksTestSuite) TestV2PacketCallbacks() {
+	suite.SetupEnv()
+	middleware := suite.v2Middleware()
+	sender := suite.TestAddress.GetAddress()
+
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    "stake",
+		Amount:   "1",
+		Sender:   sender.String(),
+		Receiver: suite.EchoContractAddr.String(),
+		Memo:     fmt.Sprintf(`{"ibc_callback": %q}`, suite.CounterContractAddr),
+	}
+	send := func(sequence uint64) error {
+		return middleware.OnSendPacket(suite.Ctx, testSourceClient, testDestinationClient, sequence, v2Payload(data), sender)
+	}
+	count := func() string {
+		count, err := suite.App.WasmKeeper.QuerySmart(suite.Ctx, suite.CounterContractAddr, []byte(fmt.Sprintf(`{"get_count":{"addr": %q}}`, suite.CounterContractAddr)))
+		suite.Require().NoError(err)
+		return string(count)
+	}
+
+	// the sender must be authorized by the callback contract
+	suite.Require().ErrorIs(send(1), ibchookstypes.ErrUnauthorizedCallback)
+	suite.App.IBCHooksKeeper.SetCallbackAuthorization(suite.Ctx, suite.CounterContractAddr.String(), sender.String(), true)
+
+	// the callback is stored with the latest timeout core accepts, so that it expires
+	suite.Require().NoError(send(1))
+	record, found := suite.App.IBCHooksKeeper.GetPacketCallbackRecord(suite.Ctx, testSourceClient, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.CounterContractAddr.String(), record.Contract)
+	suite.Require().Equal(uint64(suite.Ctx.BlockTime().Add(channeltypesv2.MaxTimeoutDelta).UnixNano()), record.TimeoutTimestamp)
+
+	// the ack is delivered to the contract
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	err := middleware.OnAcknowledgementPacket(suite.Ctx, testSourceClient, testDestinationClient, 1, ack, v2Payload(data), sender)
+	suite.Require().NoError(err)
+	suite.Require().Equal(`{"count":1}`, count())
+	suite.Require().Empty(suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, testSourceClient, 1))
+
+	// and so is the timeout
+	suite.Require().NoError(send(2))
+	err = middleware.OnTimeoutPacket(suite.Ctx, testSourceClient, testDestinationClient, 2, v2Payload(data), sender)
+	suite.Require().NoError(err)
+	suite.Require().Equal(`{"count":11}`, count())
+	suite.Require().Empty(suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, testSourceClient, 2))
+
+	// the send guards apply to v2 packets, before the funds are escrowed. The counter contract doesn't implement the
+	// send guard sudo, so every packet is rejected
+	params := ibchookstypes.DefaultParams()
+	params.SendGuards = []string{suite.CounterContractAddr.String()}
+	suite.Require().NoError(suite.App.IBCHooksKeeper.SetParams(suite.Ctx, params))
+	balance := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "stake")
+	suite.Require().ErrorIs(send(3), ibchookstypes.ErrSendGuardRejected)
+	suite.Require().Equal(balance, suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "stake"))
+	suite.Require().Empty(suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, testSourceClient, 3))
+}
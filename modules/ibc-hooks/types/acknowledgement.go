@@ -0,0 +1,62 @@
+package types
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+var _ ibcexported.Acknowledgement = RawAcknowledgement{}
+
+// RawAcknowledgement is an acknowledgement whose bytes are provided by a contract, so it can follow the
+// protocol of the packet it acknowledges.
+type RawAcknowledgement struct {
+	ack     []byte
+	success bool
+}
+
+func NewRawAcknowledgement(ack []byte, success bool) RawAcknowledgement {
+	return RawAcknowledgement{
+		ack:     ack,
+		success: success,
+	}
+}
+
+// Success implements the Acknowledgement interface
+func (a RawAcknowledgement) Success() bool {
+	return a.success
+}
+
+// Acknowledgement implements the Acknowledgement interface
+func (a RawAcknowledgement) Acknowledgement() []byte {
+	return a.ack
+}
+
+// IsJSONAckError checks an IBC acknowledgement to see if it's an error.
+func IsJSONAckError(acknowledgement []byte) bool {
+	var ackErr channeltypes.Acknowledgement_Error
+	if err := json.Unmarshal(acknowledgement, &ackErr); err == nil && len(ackErr.Error) > 0 {
+		return true
+	}
+	return false
+}
+
+// ValidateRawAcknowledgement checks that the acknowledgement bytes provided by a contract are a channel
+// acknowledgement whose outcome matches the claimed success, so that the sender chain and the middlewares of this
+// chain interpret the acknowledgement the same way.
+func ValidateRawAcknowledgement(bz []byte, success bool) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(bz, &ack); err != nil {
+		return errors.Wrapf(ErrInvalidAck, "cannot unmarshal channel acknowledgement: %s", err)
+	}
+	if err := ack.ValidateBasic(); err != nil {
+		return errors.Wrap(ErrInvalidAck, err.Error())
+	}
+	if ack.Success() != success {
+		return errors.Wrapf(ErrInvalidAck, "acknowledgement success is %t, expected %t", ack.Success(), success)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// ModuleCdc references the global ibc-hooks module codec. Note, the codec should ONLY be used in certain
+// instances of tests and for JSON encoding, and to encode the module's state.
+var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRegisterPacketCallback{},
+		&MsgUpdateCallbackAuthorization{},
+		&MsgWriteHookAcknowledgement{},
+		&MsgUpdateParams{},
+		&MsgSubscribeTransfers{},
+		&MsgUnsubscribeTransfers{},
+		&MsgRecoverStrandedFunds{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
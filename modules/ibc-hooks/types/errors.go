@@ -5,6 +5,7 @@ import errors "cosmossdk.io/errors"
 var (
 	ErrBadMetadataFormatMsg = "wasm metadata not properly formatted for: '%v'. %s"
 	ErrBadExecutionMsg      = "cannot execute contract: %v"
+	ErrBadModuleMsg         = "cannot execute module msg: %v"
 
 	ErrMsgValidation = errors.Register(ModuleName, 2, "error in wasmhook message validation")
 	ErrMarshaling    = errors.Register("wasm-hooks", 3, "cannot marshal the ICS20 packet")
@@ -12,4 +13,16 @@ var (
 	ErrBadResponse   = errors.Register("wasm-hooks", 5, "cannot create response")
 	ErrWasmError     = errors.Register("wasm-hooks", 6, "wasm error")
 	ErrBadSender     = errors.Register("wasm-hooks", 7, "bad sender")
+
+	ErrModuleHookError = errors.Register("wasm-hooks", 8, "module hook error")
+
+	ErrUnauthorizedCallback = errors.Register("wasm-hooks", 9, "unauthorized callback registration")
+	ErrPacketNotFound       = errors.Register("wasm-hooks", 10, "packet commitment not found")
+	ErrCallbackExists       = errors.Register("wasm-hooks", 11, "packet callback already registered")
+	ErrInvalidAck           = errors.Register("wasm-hooks", 12, "invalid acknowledgement")
+	ErrPendingAckNotFound   = errors.Register("wasm-hooks", 13, "pending acknowledgement not found")
+	ErrSendGuardRejected    = errors.Register("wasm-hooks", 14, "packet rejected by send guard")
+	ErrTooManyObservers     = errors.Register("wasm-hooks", 15, "too many observers")
+	ErrInvalidRelayerFee    = errors.Register("wasm-hooks", 16, "invalid relayer fee")
+	ErrNoStrandedFunds      = errors.Register("wasm-hooks", 17, "no stranded funds")
 )
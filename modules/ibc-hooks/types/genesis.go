@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+)
+
+// DefaultGenesis creates and returns the default ibc-hooks GenesisState
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic validation of the GenesisState
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	callbacks := make(map[string]struct{}, len(gs.Callbacks))
+	for _, record := range gs.Callbacks {
+		if _, err := sdk.AccAddressFromBech32(record.Contract); err != nil {
+			return fmt.Errorf("invalid callback contract %s: %w", record.Contract, err)
+		}
+		if record.ChannelId == "" || record.Sequence == 0 {
+			return fmt.Errorf("invalid callback of packet %s/%d", record.ChannelId, record.Sequence)
+		}
+		if err := checkDuplicate(callbacks, fmt.Sprintf("%s/%d", record.ChannelId, record.Sequence)); err != nil {
+			return fmt.Errorf("callback: %w", err)
+		}
+	}
+
+	pendingAcks := make(map[string]struct{}, len(gs.PendingAcknowledgements))
+	for _, pendingAck := range gs.PendingAcknowledgements {
+		if _, err := sdk.AccAddressFromBech32(pendingAck.Contract); err != nil {
+			return fmt.Errorf("invalid pending acknowledgement contract %s: %w", pendingAck.Contract, err)
+		}
+		var packet channeltypes.Packet
+		if err := channeltypes.SubModuleCdc.Unmarshal(pendingAck.Packet, &packet); err != nil {
+			return fmt.Errorf("invalid pending acknowledgement packet: %w", err)
+		}
+		if err := packet.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid pending acknowledgement packet: %w", err)
+		}
+		if err := checkDuplicate(pendingAcks, fmt.Sprintf("%s/%d", packet.DestinationChannel, packet.Sequence)); err != nil {
+			return fmt.Errorf("pending acknowledgement: %w", err)
+		}
+	}
+
+	authorizations := make(map[string]struct{}, len(gs.CallbackAuthorizations))
+	for _, authorization := range gs.CallbackAuthorizations {
+		if _, err := sdk.AccAddressFromBech32(authorization.Contract); err != nil {
+			return fmt.Errorf("invalid callback authorization contract %s: %w", authorization.Contract, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(authorization.Sender); err != nil {
+			return fmt.Errorf("invalid callback authorization sender %s: %w", authorization.Sender, err)
+		}
+		if err := checkDuplicate(authorizations, authorization.Contract+"/"+authorization.Sender); err != nil {
+			return fmt.Errorf("callback authorization: %w", err)
+		}
+	}
+
+	strandedFunds := make(map[string]struct{}, len(gs.StrandedFunds))
+	for _, funds := range gs.StrandedFunds {
+		if _, err := sdk.AccAddressFromBech32(funds.Address); err != nil {
+			return fmt.Errorf("invalid stranded funds address %s: %w", funds.Address, err)
+		}
+		if funds.ChannelId == "" || funds.OriginalSender == "" {
+			return fmt.Errorf("stranded funds of %s must have a channel and an original sender", funds.Address)
+		}
+		if err := checkDuplicate(strandedFunds, funds.Address); err != nil {
+			return fmt.Errorf("stranded funds: %w", err)
+		}
+	}
+
+	observers := make(map[string]struct{}, len(gs.Observers))
+	subscriptions := make(map[string]uint64)
+	for _, observer := range gs.Observers {
+		if _, err := sdk.AccAddressFromBech32(observer.Contract); err != nil {
+			return fmt.Errorf("invalid observer contract %s: %w", observer.Contract, err)
+		}
+		if (observer.ChannelId == "") == (observer.Denom == "") {
+			return fmt.Errorf("exactly one of the channel and the denom of observer %s must be set", observer.Contract)
+		}
+		subscription := "channel/" + observer.ChannelId
+		if observer.Denom != "" {
+			subscription = "denom/" + observer.Denom
+		}
+		if err := checkDuplicate(observers, subscription+"/"+observer.Contract); err != nil {
+			return fmt.Errorf("observer: %w", err)
+		}
+		subscriptions[subscription]++
+		if subscriptions[subscription] > gs.Params.MaxObservers {
+			return fmt.Errorf("%s has more than %d observers", subscription, gs.Params.MaxObservers)
+		}
+	}
+
+	intermediateSenders := make(map[string]struct{}, len(gs.IntermediateSenders))
+	for _, intermediateSender := range gs.IntermediateSenders {
+		// the intermediate senders are keyed by their address bytes, so any bech32 prefix can be used
+		_, bz, err := bech32.DecodeAndConvert(intermediateSender.Address)
+		if err != nil {
+			return fmt.Errorf("invalid intermediate sender %s: %w", intermediateSender.Address, err)
+		}
+		if intermediateSender.Origin.ChannelId == "" || intermediateSender.Origin.OriginalSender == "" {
+			return fmt.Errorf("intermediate sender %s must have a channel and an original sender", intermediateSender.Address)
+		}
+		if err := checkDuplicate(intermediateSenders, sdk.AccAddress(bz).String()); err != nil {
+			return fmt.Errorf("intermediate sender %s: %w", intermediateSender.Address, err)
+		}
+	}
+	return nil
+}
+
+func checkDuplicate(seen map[string]struct{}, key string) error {
+	if _, ok := seen[key]; ok {
+		return fmt.Errorf("duplicate %s", key)
+	}
+	seen[key] = struct{}{}
+	return nil
+}
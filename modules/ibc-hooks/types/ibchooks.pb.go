@@ -0,0 +1,1889 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibchooks/v1/ibchooks.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the ibc-hooks module.
+type Params struct {
+	// send_guards are the contracts that approve, reject or add a memo to every outgoing ICS-20 packet.
+	SendGuards []string `protobuf:"bytes,1,rep,name=send_guards,json=sendGuards,proto3" json:"send_guards,omitempty"`
+	// observer_gas_limit is the gas available to each observer to process an ibc_transfer_received notification.
+	ObserverGasLimit uint64 `protobuf:"varint,2,opt,name=observer_gas_limit,json=observerGasLimit,proto3" json:"observer_gas_limit,omitempty"`
+	// max_observers is the maximum number of observers subscribed to a single channel or denom.
+	MaxObservers uint64 `protobuf:"varint,3,opt,name=max_observers,json=maxObservers,proto3" json:"max_observers,omitempty"`
+	// max_relayer_fee_bps is the maximum relayer fee a wasm memo can set, in basis points of the transferred amount.
+	// Zero disables relayer fees.
+	MaxRelayerFeeBps uint32 `protobuf:"varint,4,opt,name=max_relayer_fee_bps,json=maxRelayerFeeBps,proto3" json:"max_relayer_fee_bps,omitempty"`
+	// callback_expiry_delay is the time after the timeout of a packet its callback is pruned, if the packet has
+	// neither been acknowledged nor timed out.
+	CallbackExpiryDelay time.Duration `protobuf:"bytes,5,opt,name=callback_expiry_delay,json=callbackExpiryDelay,proto3,stdduration" json:"callback_expiry_delay"`
+	// max_callbacks_checked_per_block is the number of callbacks checked for expiry at the end of each block.
+	MaxCallbacksCheckedPerBlock uint32 `protobuf:"varint,6,opt,name=max_callbacks_checked_per_block,json=maxCallbacksCheckedPerBlock,proto3" json:"max_callbacks_checked_per_block,omitempty"`
+	// expired_callback_gas_limit is the gas available to a contract to process an expired callback.
+	ExpiredCallbackGasLimit uint64 `protobuf:"varint,7,opt,name=expired_callback_gas_limit,json=expiredCallbackGasLimit,proto3" json:"expired_callback_gas_limit,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8177dc0bb10bd83f, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetSendGuards() []string {
+	if m != nil {
+		return m.SendGuards
+	}
+	return nil
+}
+
+func (m *Params) GetObserverGasLimit() uint64 {
+	if m != nil {
+		return m.ObserverGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxObservers() uint64 {
+	if m != nil {
+		return m.MaxObservers
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRelayerFeeBps() uint32 {
+	if m != nil {
+		return m.MaxRelayerFeeBps
+	}
+	return 0
+}
+
+func (m *Params) GetCallbackExpiryDelay() time.Duration {
+	if m != nil {
+		return m.CallbackExpiryDelay
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCallbacksCheckedPerBlock() uint32 {
+	if m != nil {
+		return m.MaxCallbacksCheckedPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetExpiredCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.ExpiredCallbackGasLimit
+	}
+	return 0
+}
+
+// StrandedFunds identifies an intermediate sender that kept the funds of a packet whose contract
+// execution failed with the "keep" on_failure mode.
+type StrandedFunds struct {
+	// address is the intermediate sender holding the funds.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// channel_id is the destination channel of the packet.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// original_sender is the sender of the packet on the counterparty chain.
+	OriginalSender string `protobuf:"bytes,3,opt,name=original_sender,json=originalSender,proto3" json:"original_sender,omitempty"`
+}
+
+func (m *StrandedFunds) Reset()         { *m = StrandedFunds{} }
+func (m *StrandedFunds) String() string { return proto.CompactTextString(m) }
+func (*StrandedFunds) ProtoMessage()    {}
+func (*StrandedFunds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8177dc0bb10bd83f, []int{1}
+}
+func (m *StrandedFunds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StrandedFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StrandedFunds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StrandedFunds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StrandedFunds.Merge(m, src)
+}
+func (m *StrandedFunds) XXX_Size() int {
+	return m.Size()
+}
+func (m *StrandedFunds) XXX_DiscardUnknown() {
+	xxx_messageInfo_StrandedFunds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StrandedFunds proto.InternalMessageInfo
+
+func (m *StrandedFunds) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *StrandedFunds) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *StrandedFunds) GetOriginalSender() string {
+	if m != nil {
+		return m.OriginalSender
+	}
+	return ""
+}
+
+// PendingAcknowledgement is a received packet whose acknowledgement will be written asynchronously by the
+// contract it was routed to.
+type PendingAcknowledgement struct {
+	// contract is the contract allowed to write the acknowledgement.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// packet is the proto encoded channel packet, as received.
+	Packet []byte `protobuf:"bytes,2,opt,name=packet,proto3" json:"packet,omitempty"`
+}
+
+func (m *PendingAcknowledgement) Reset()         { *m = PendingAcknowledgement{} }
+func (m *PendingAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*PendingAcknowledgement) ProtoMessage()    {}
+func (*PendingAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8177dc0bb10bd83f, []int{2}
+}
+func (m *PendingAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAcknowledgement.Merge(m, src)
+}
+func (m *PendingAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingAcknowledgement proto.InternalMessageInfo
+
+func (m *PendingAcknowledgement) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *PendingAcknowledgement) GetPacket() []byte {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+// IntermediateSenderOrigin is the channel and original sender an intermediate sender was derived from.
+type IntermediateSenderOrigin struct {
+	// channel_id is the destination channel of the packets.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// original_sender is the sender of the packets on the counterparty chain.
+	OriginalSender string `protobuf:"bytes,2,opt,name=original_sender,json=originalSender,proto3" json:"original_sender,omitempty"`
+}
+
+func (m *IntermediateSenderOrigin) Reset()         { *m = IntermediateSenderOrigin{} }
+func (m *IntermediateSenderOrigin) String() string { return proto.CompactTextString(m) }
+func (*IntermediateSenderOrigin) ProtoMessage()    {}
+func (*IntermediateSenderOrigin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8177dc0bb10bd83f, []int{3}
+}
+func (m *IntermediateSenderOrigin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntermediateSenderOrigin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntermediateSenderOrigin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IntermediateSenderOrigin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntermediateSenderOrigin.Merge(m, src)
+}
+func (m *IntermediateSenderOrigin) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntermediateSenderOrigin) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntermediateSenderOrigin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntermediateSenderOrigin proto.InternalMessageInfo
+
+func (m *IntermediateSenderOrigin) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IntermediateSenderOrigin) GetOriginalSender() string {
+	if m != nil {
+		return m.OriginalSender
+	}
+	return ""
+}
+
+// PacketCallbackRecord is the contract notified of the acknowledgement or timeout of a sent packet.
+type PacketCallbackRecord struct {
+	// contract is the contract receiving the ibc_lifecycle_complete sudo message.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// port_id is the source port of the packet.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the source channel of the packet, or its source client for IBC v2 packets.
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence of the packet.
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// registered_height is the height the callback was registered at.
+	RegisteredHeight int64 `protobuf:"varint,5,opt,name=registered_height,json=registeredHeight,proto3" json:"registered_height,omitempty"`
+	// registered_time is the block time the callback was registered at.
+	RegisteredTime time.Time `protobuf:"bytes,6,opt,name=registered_time,json=registeredTime,proto3,stdtime" json:"registered_time"`
+	// sender is the sender of the packet, or of the MsgRegisterPacketCallback. It is empty for callbacks migrated from
+	// the consensus version 2.
+	Sender string `protobuf:"bytes,7,opt,name=sender,proto3" json:"sender,omitempty"`
+	// timeout_timestamp is the timeout timestamp of the packet, in nanoseconds. Zero if unknown or not set. Once the
+	// timeout height is reached, or the channel of a callback without a known timeout is closed, it is set to the time
+	// of the block the expiry check saw it.
+	TimeoutTimestamp uint64 `protobuf:"varint,8,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// timeout_revision_number and timeout_revision_height are the timeout height of the packet on the counterparty
+	// chain. Zero if unknown or not set.
+	TimeoutRevisionNumber uint64 `protobuf:"varint,9,opt,name=timeout_revision_number,json=timeoutRevisionNumber,proto3" json:"timeout_revision_number,omitempty"`
+	TimeoutRevisionHeight uint64 `protobuf:"varint,10,opt,name=timeout_revision_height,json=timeoutRevisionHeight,proto3" json:"timeout_revision_height,omitempty"`
+}
+
+func (m *PacketCallbackRecord) Reset()         { *m = PacketCallbackRecord{} }
+func (m *PacketCallbackRecord) String() string { return proto.CompactTextString(m) }
+func (*PacketCallbackRecord) ProtoMessage()    {}
+func (*PacketCallbackRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8177dc0bb10bd83f, []int{4}
+}
+func (m *PacketCallbackRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketCallbackRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketCallbackRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketCallbackRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketCallbackRecord.Merge(m, src)
+}
+func (m *PacketCallbackRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketCallbackRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketCallbackRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketCallbackRecord proto.InternalMessageInfo
+
+func (m *PacketCallbackRecord) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *PacketCallbackRecord) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PacketCallbackRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PacketCallbackRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PacketCallbackRecord) GetRegisteredHeight() int64 {
+	if m != nil {
+		return m.RegisteredHeight
+	}
+	return 0
+}
+
+func (m *PacketCallbackRecord) GetRegisteredTime() time.Time {
+	if m != nil {
+		return m.RegisteredTime
+	}
+	return time.Time{}
+}
+
+func (m *PacketCallbackRecord) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PacketCallbackRecord) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *PacketCallbackRecord) GetTimeoutRevisionNumber() uint64 {
+	if m != nil {
+		return m.TimeoutRevisionNumber
+	}
+	return 0
+}
+
+func (m *PacketCallbackRecord) GetTimeoutRevisionHeight() uint64 {
+	if m != nil {
+		return m.TimeoutRevisionHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "ibchooks.v1.Params")
+	proto.RegisterType((*StrandedFunds)(nil), "ibchooks.v1.StrandedFunds")
+	proto.RegisterType((*PendingAcknowledgement)(nil), "ibchooks.v1.PendingAcknowledgement")
+	proto.RegisterType((*IntermediateSenderOrigin)(nil), "ibchooks.v1.IntermediateSenderOrigin")
+	proto.RegisterType((*PacketCallbackRecord)(nil), "ibchooks.v1.PacketCallbackRecord")
+}
+
+func init() { proto.RegisterFile("ibchooks/v1/ibchooks.proto", fileDescriptor_8177dc0bb10bd83f) }
+
+var fileDescriptor_8177dc0bb10bd83f = []byte{
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x6f, 0x1c, 0x35,
+	0x14, 0xce, 0x74, 0xc3, 0x26, 0xeb, 0x34, 0x6d, 0x70, 0xd3, 0x66, 0xba, 0x88, 0xcd, 0x2a, 0x1c,
+	0x58, 0x09, 0xb2, 0xab, 0x04, 0x54, 0x09, 0x71, 0xea, 0x36, 0xb4, 0x44, 0x02, 0xba, 0x9a, 0x20,
+	0x21, 0x71, 0xb1, 0x3c, 0xe3, 0x97, 0x59, 0x6b, 0x67, 0xec, 0xc1, 0xf6, 0x2c, 0x9b, 0xdf, 0xc0,
+	0xa5, 0x47, 0xae, 0xfc, 0x07, 0x7e, 0x44, 0x8f, 0x85, 0x13, 0x27, 0x40, 0xc9, 0x1f, 0x41, 0xb6,
+	0xc7, 0x9b, 0x68, 0x89, 0x00, 0x71, 0x9b, 0xf7, 0x7d, 0x9f, 0xdf, 0xbc, 0xf7, 0xbd, 0x67, 0xa3,
+	0x2e, 0x4f, 0xb3, 0xa9, 0x94, 0x33, 0x3d, 0x9a, 0x1f, 0x8d, 0xc2, 0xf7, 0xb0, 0x52, 0xd2, 0x48,
+	0xbc, 0xb5, 0x8c, 0xe7, 0x47, 0xdd, 0xc7, 0x99, 0xd4, 0xa5, 0xd4, 0xc4, 0x51, 0x23, 0x1f, 0x78,
+	0x5d, 0x77, 0x37, 0x97, 0xb9, 0xf4, 0xb8, 0xfd, 0x6a, 0xd0, 0x5e, 0x2e, 0x65, 0x5e, 0xc0, 0xc8,
+	0x45, 0x69, 0x7d, 0x3e, 0x62, 0xb5, 0xa2, 0x86, 0x4b, 0xd1, 0xf0, 0xfb, 0xab, 0xbc, 0xe1, 0x25,
+	0x68, 0x43, 0xcb, 0xca, 0x0b, 0x0e, 0x7e, 0x6a, 0xa1, 0xf6, 0x84, 0x2a, 0x5a, 0x6a, 0xfc, 0x09,
+	0xda, 0xd2, 0x20, 0x18, 0xc9, 0x6b, 0xaa, 0x98, 0x8e, 0xa3, 0x7e, 0x6b, 0xd0, 0x19, 0xc7, 0xbf,
+	0xfe, 0x7c, 0xb8, 0xdb, 0x14, 0xf2, 0x94, 0x31, 0x05, 0x5a, 0x9f, 0x19, 0xc5, 0x45, 0x9e, 0x20,
+	0x2b, 0x7e, 0xe1, 0xb4, 0xf8, 0x43, 0x84, 0x65, 0xaa, 0x41, 0xcd, 0x41, 0x91, 0x9c, 0x6a, 0x52,
+	0xf0, 0x92, 0x9b, 0xf8, 0x4e, 0x3f, 0x1a, 0xac, 0x27, 0x3b, 0x81, 0x79, 0x41, 0xf5, 0x17, 0x16,
+	0xc7, 0xef, 0xa1, 0xed, 0x92, 0x2e, 0x48, 0xc0, 0x75, 0xdc, 0x72, 0xc2, 0xbb, 0x25, 0x5d, 0xbc,
+	0x0c, 0x18, 0x3e, 0x44, 0x0f, 0xac, 0x48, 0x41, 0x41, 0x2f, 0x40, 0x91, 0x73, 0x00, 0x92, 0x56,
+	0x3a, 0x5e, 0xef, 0x47, 0x83, 0xed, 0x64, 0xa7, 0xa4, 0x8b, 0xc4, 0x33, 0xcf, 0x01, 0xc6, 0x95,
+	0xc6, 0xdf, 0xa0, 0x87, 0x19, 0x2d, 0x8a, 0x94, 0x66, 0x33, 0x02, 0x8b, 0x8a, 0xab, 0x0b, 0xc2,
+	0xac, 0x20, 0x7e, 0xab, 0x1f, 0x0d, 0xb6, 0x8e, 0x1f, 0x0f, 0xbd, 0x11, 0xc3, 0x60, 0xc4, 0xf0,
+	0xa4, 0x31, 0x6a, 0xbc, 0xf9, 0xfa, 0xf7, 0xfd, 0xb5, 0x1f, 0xff, 0xd8, 0x8f, 0x92, 0x07, 0x21,
+	0xc3, 0x67, 0x2e, 0xc1, 0x89, 0x3d, 0x8f, 0x4f, 0xd0, 0xbe, 0xad, 0x23, 0x50, 0x9a, 0x64, 0x53,
+	0xc8, 0x66, 0xc0, 0x48, 0x05, 0x8a, 0xa4, 0x85, 0xcc, 0x66, 0x71, 0xdb, 0xd5, 0xf4, 0x4e, 0x49,
+	0x17, 0xcf, 0x82, 0xea, 0x99, 0x17, 0x4d, 0x40, 0x8d, 0xad, 0x04, 0x7f, 0x8a, 0xba, 0xae, 0x2a,
+	0x60, 0xcb, 0x4c, 0x37, 0x8c, 0xda, 0x70, 0xfd, 0xef, 0x35, 0x8a, 0x90, 0x24, 0xf8, 0x75, 0xf0,
+	0x43, 0x84, 0xb6, 0xcf, 0x8c, 0xa2, 0x82, 0x01, 0x7b, 0x5e, 0x0b, 0xa6, 0xf1, 0x31, 0xda, 0xa0,
+	0x7e, 0x18, 0x71, 0xd4, 0x8f, 0xfe, 0x71, 0x4c, 0x41, 0x88, 0xdf, 0x45, 0x28, 0x9b, 0x52, 0x21,
+	0xa0, 0x20, 0x9c, 0xb9, 0xd9, 0x74, 0x92, 0x4e, 0x83, 0x9c, 0x32, 0xfc, 0x3e, 0xba, 0x2f, 0x15,
+	0xcf, 0xb9, 0xa0, 0x05, 0xb1, 0x93, 0x05, 0xe5, 0xc6, 0xd2, 0x49, 0xee, 0x05, 0xf8, 0xcc, 0xa1,
+	0x07, 0xe7, 0xe8, 0xd1, 0x04, 0x04, 0xe3, 0x22, 0x7f, 0x9a, 0xcd, 0x84, 0xfc, 0xbe, 0x00, 0x96,
+	0x43, 0x09, 0xc2, 0xe0, 0x8f, 0xd1, 0x66, 0x26, 0x85, 0x51, 0x34, 0x33, 0xff, 0x5a, 0xd6, 0x52,
+	0x89, 0x1f, 0xa1, 0x76, 0x45, 0xb3, 0x19, 0xf8, 0x7d, 0xb9, 0x9b, 0x34, 0xd1, 0x41, 0x8a, 0xe2,
+	0x53, 0x61, 0x40, 0x95, 0xc0, 0x38, 0x35, 0xe0, 0xff, 0xfe, 0xd2, 0xd5, 0xb2, 0xd2, 0x4b, 0xf4,
+	0x1f, 0x7a, 0xb9, 0x73, 0x6b, 0x2f, 0xbf, 0xb4, 0xd0, 0xee, 0xc4, 0xfd, 0x2e, 0x98, 0x9e, 0x40,
+	0x26, 0x15, 0xfb, 0x9f, 0xad, 0xec, 0xa1, 0x8d, 0x4a, 0x2a, 0x73, 0xed, 0x6f, 0xdb, 0x86, 0xa7,
+	0x6c, 0xa5, 0xde, 0xd6, 0x6a, 0xbd, 0x5d, 0xb4, 0xa9, 0xe1, 0xbb, 0x1a, 0x44, 0x06, 0x6e, 0xc1,
+	0xd7, 0x93, 0x65, 0x8c, 0x3f, 0x40, 0x6f, 0x2b, 0xc8, 0xb9, 0x36, 0x60, 0x97, 0x67, 0x0a, 0x3c,
+	0x9f, 0x1a, 0xb7, 0xd4, 0xad, 0x64, 0xe7, 0x9a, 0xf8, 0xdc, 0xe1, 0xf8, 0x4b, 0x74, 0xff, 0x86,
+	0xd8, 0xde, 0x75, 0xb7, 0x9c, 0x5b, 0xc7, 0xdd, 0xbf, 0xed, 0xff, 0xd7, 0xe1, 0x21, 0xf0, 0x17,
+	0xe0, 0x95, 0xbd, 0x00, 0xf7, 0xae, 0x0f, 0x5b, 0xda, 0x8e, 0xa6, 0xb1, 0x6f, 0xc3, 0xb7, 0xe3,
+	0x23, 0x5b, 0x93, 0xcd, 0x2d, 0x6b, 0x43, 0x96, 0xef, 0x49, 0xbc, 0xe9, 0x6f, 0x7b, 0x43, 0x2c,
+	0xd3, 0xe3, 0x27, 0x68, 0x2f, 0x88, 0x15, 0xcc, 0xb9, 0xe6, 0x52, 0x10, 0x51, 0x97, 0x29, 0xa8,
+	0xb8, 0xe3, 0x8e, 0x3c, 0x6c, 0xe8, 0xa4, 0x61, 0xbf, 0x72, 0xe4, 0xad, 0xe7, 0x9a, 0xf6, 0xd1,
+	0xad, 0xe7, 0xbc, 0x07, 0xe3, 0xc9, 0xeb, 0xcb, 0x5e, 0xf4, 0xe6, 0xb2, 0x17, 0xfd, 0x79, 0xd9,
+	0x8b, 0x5e, 0x5d, 0xf5, 0xd6, 0xde, 0x5c, 0xf5, 0xd6, 0x7e, 0xbb, 0xea, 0xad, 0x7d, 0xfb, 0x24,
+	0xe7, 0x66, 0x5a, 0xa7, 0xc3, 0x4c, 0x96, 0xcd, 0xdb, 0x6a, 0x1f, 0xe3, 0x43, 0x5a, 0x55, 0x7a,
+	0x54, 0x4a, 0x56, 0x17, 0xe0, 0x81, 0xf0, 0x54, 0x1f, 0x8d, 0xcc, 0x45, 0x05, 0x3a, 0x6d, 0x3b,
+	0xd3, 0x3e, 0xfa, 0x6b, 0x00, 0xe3, 0x06, 0x3c, 0x28, 0xc7, 0x05, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiredCallbackGasLimit != 0 {
+		i = encodeVarintIbchooks(dAtA, i, uint64(m.ExpiredCallbackGasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxCallbacksCheckedPerBlock != 0 {
+		i = encodeVarintIbchooks(dAtA, i, uint64(m.MaxCallbacksCheckedPerBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CallbackExpiryDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CallbackExpiryDelay):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintIbchooks(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.MaxRelayerFeeBps != 0 {
+		i = encodeVarintIbchooks(dAtA, i, uint64(m.MaxRelayerFeeBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxObservers != 0 {
+		i = encodeVarintIbchooks(dAtA, i, uint64(m.MaxObservers))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ObserverGasLimit != 0 {
+		i = encodeVarintIbchooks(dAtA, i, uint64(m.ObserverGasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SendGuards) > 0 {
+		for iNdEx := len(m.SendGuards) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SendGuards[iNdEx])
+			copy(dAtA[i:], m.SendGuards[iNdEx])
+			i = encodeVarintIbchooks(dAtA, i, uint64(len(m.SendGuards[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StrandedFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StrandedFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StrandedFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OriginalSender) > 0 {
+		i -= len(m.OriginalSender)
+		copy(dAtA[i:], m.OriginalSender)
+		i = encodeVarintIbchooks(dAtA, i, uint64(len(m.OriginalSender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIbchooks(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintIbchooks(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Packet) > 0 {
+		i -= len(m.Packet)
+		copy(dAtA[i:], m.Packet)
+		i = encodeVarintIbchooks(dAtA, i, uint64(len(m.Packet)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIbchooks(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IntermediateSenderOrigin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntermediateSenderOrigin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntermediateSenderOrigin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OriginalSender) > 0 {
+		i -= len(m.OriginalSender)
+		copy(dAtA[i:], m.OriginalSender)
+		i = encodeVarintIbchooks(dAtA, i, uint64(len(m.OriginalSender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIbchooks(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketCallbackRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketCallbackRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketCallbackRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutRevisionHeight != 0 {
+		i = encodeVarintIbchooks(dAtA, i, uint64(m.TimeoutRevisionHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TimeoutRevisionNumber != 0 {
+		i = encodeVarintIbchooks(dAtA, i, uint64(m.TimeoutRevisionNumber))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintIbchooks(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintIbchooks(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RegisteredTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RegisteredTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintIbchooks(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.RegisteredHeight != 0 {
+		i = encodeVarintIbchooks(dAtA, i, uint64(m.RegisteredHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintIbchooks(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIbchooks(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintIbchooks(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIbchooks(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIbchooks(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIbchooks(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SendGuards) > 0 {
+		for _, s := range m.SendGuards {
+			l = len(s)
+			n += 1 + l + sovIbchooks(uint64(l))
+		}
+	}
+	if m.ObserverGasLimit != 0 {
+		n += 1 + sovIbchooks(uint64(m.ObserverGasLimit))
+	}
+	if m.MaxObservers != 0 {
+		n += 1 + sovIbchooks(uint64(m.MaxObservers))
+	}
+	if m.MaxRelayerFeeBps != 0 {
+		n += 1 + sovIbchooks(uint64(m.MaxRelayerFeeBps))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CallbackExpiryDelay)
+	n += 1 + l + sovIbchooks(uint64(l))
+	if m.MaxCallbacksCheckedPerBlock != 0 {
+		n += 1 + sovIbchooks(uint64(m.MaxCallbacksCheckedPerBlock))
+	}
+	if m.ExpiredCallbackGasLimit != 0 {
+		n += 1 + sovIbchooks(uint64(m.ExpiredCallbackGasLimit))
+	}
+	return n
+}
+
+func (m *StrandedFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovIbchooks(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIbchooks(uint64(l))
+	}
+	l = len(m.OriginalSender)
+	if l > 0 {
+		n += 1 + l + sovIbchooks(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovIbchooks(uint64(l))
+	}
+	l = len(m.Packet)
+	if l > 0 {
+		n += 1 + l + sovIbchooks(uint64(l))
+	}
+	return n
+}
+
+func (m *IntermediateSenderOrigin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIbchooks(uint64(l))
+	}
+	l = len(m.OriginalSender)
+	if l > 0 {
+		n += 1 + l + sovIbchooks(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketCallbackRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovIbchooks(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovIbchooks(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIbchooks(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovIbchooks(uint64(m.Sequence))
+	}
+	if m.RegisteredHeight != 0 {
+		n += 1 + sovIbchooks(uint64(m.RegisteredHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RegisteredTime)
+	n += 1 + l + sovIbchooks(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovIbchooks(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovIbchooks(uint64(m.TimeoutTimestamp))
+	}
+	if m.TimeoutRevisionNumber != 0 {
+		n += 1 + sovIbchooks(uint64(m.TimeoutRevisionNumber))
+	}
+	if m.TimeoutRevisionHeight != 0 {
+		n += 1 + sovIbchooks(uint64(m.TimeoutRevisionHeight))
+	}
+	return n
+}
+
+func sovIbchooks(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIbchooks(x uint64) (n int) {
+	return sovIbchooks(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbchooks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendGuards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendGuards = append(m.SendGuards, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverGasLimit", wireType)
+			}
+			m.ObserverGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObserverGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxObservers", wireType)
+			}
+			m.MaxObservers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxObservers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRelayerFeeBps", wireType)
+			}
+			m.MaxRelayerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRelayerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackExpiryDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CallbackExpiryDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallbacksCheckedPerBlock", wireType)
+			}
+			m.MaxCallbacksCheckedPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallbacksCheckedPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredCallbackGasLimit", wireType)
+			}
+			m.ExpiredCallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredCallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbchooks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StrandedFunds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbchooks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StrandedFunds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StrandedFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbchooks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbchooks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packet = append(m.Packet[:0], dAtA[iNdEx:postIndex]...)
+			if m.Packet == nil {
+				m.Packet = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbchooks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IntermediateSenderOrigin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbchooks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntermediateSenderOrigin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntermediateSenderOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbchooks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketCallbackRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbchooks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketCallbackRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketCallbackRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredHeight", wireType)
+			}
+			m.RegisteredHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RegisteredTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionNumber", wireType)
+			}
+			m.TimeoutRevisionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutRevisionNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionHeight", wireType)
+			}
+			m.TimeoutRevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutRevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbchooks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbchooks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIbchooks(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIbchooks
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIbchooks
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIbchooks
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIbchooks
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIbchooks
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIbchooks        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIbchooks          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIbchooks = fmt.Errorf("proto: unexpected end of group")
+)
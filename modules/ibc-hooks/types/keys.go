@@ -1,8 +1,34 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	ModuleName     = "ibchooks"
 	StoreKey       = "hooks-for-ibc" // not using the module name because of collisions with key "ibc"
 	IBCCallbackKey = "ibc_callback"
+	ModuleHookKey  = "module"
 	SenderPrefix   = "ibc-wasm-hook-intermediary"
+
+	CallbackAuthorizationPrefix = "callback-authorization"
+	StrandedFundsPrefix         = "stranded-funds"
+	PendingAckPrefix            = "pending-ack"
+	IntermediateSenderPrefix    = "intermediate-sender"
+	ParamsKey                   = "params"
+	ObserverPrefix              = "observer"
+
+	// OnFailureKeep is the wasm on_failure mode that keeps the funds in the intermediate sender when the
+	// contract execution fails, instead of reverting the transfer with an error ack.
+	OnFailureKeep = "keep"
+	// OnFailureRevert is the default on_failure mode
+	OnFailureRevert = "revert"
+)
+
+var (
+	// PacketCallbackPrefix is the prefix of the packet callbacks. The collections use single byte prefixes, which
+	// can't collide with the string keys of the module.
+	PacketCallbackPrefix = collections.NewPrefix(1)
+	// PacketCallbackByContractPrefix is the prefix of the index of the packet callbacks by contract
+	PacketCallbackByContractPrefix = collections.NewPrefix(2)
+	// CallbackExpiryCursorPrefix is the prefix of the last callback checked for expiry
+	CallbackExpiryCursorPrefix = collections.NewPrefix(3)
 )
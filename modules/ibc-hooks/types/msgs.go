@@ -0,0 +1,156 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterPacketCallback{}
+	_ sdk.Msg = &MsgUpdateCallbackAuthorization{}
+	_ sdk.Msg = &MsgWriteHookAcknowledgement{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSubscribeTransfers{}
+	_ sdk.Msg = &MsgUnsubscribeTransfers{}
+	_ sdk.Msg = &MsgRecoverStrandedFunds{}
+)
+
+// NewMsgRegisterPacketCallback creates a new MsgRegisterPacketCallback instance
+func NewMsgRegisterPacketCallback(sender, portID, channelID string, sequence uint64) *MsgRegisterPacketCallback {
+	return &MsgRegisterPacketCallback{
+		Sender:    sender,
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  sequence,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRegisterPacketCallback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errors.Wrap(err, "invalid sender address")
+	}
+	if err := host.PortIdentifierValidator(m.PortId); err != nil {
+		return errors.Wrap(err, "invalid port id")
+	}
+	if err := host.ChannelIdentifierValidator(m.ChannelId); err != nil {
+		return errors.Wrap(err, "invalid channel id")
+	}
+	if m.Sequence == 0 {
+		return errors.Wrap(ErrMsgValidation, "packet sequence cannot be 0")
+	}
+	return nil
+}
+
+// NewMsgUpdateCallbackAuthorization creates a new MsgUpdateCallbackAuthorization instance
+func NewMsgUpdateCallbackAuthorization(contract, sender string, authorized bool) *MsgUpdateCallbackAuthorization {
+	return &MsgUpdateCallbackAuthorization{
+		Contract:   contract,
+		Sender:     sender,
+		Authorized: authorized,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateCallbackAuthorization) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Contract); err != nil {
+		return errors.Wrap(err, "invalid contract address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errors.Wrap(err, "invalid sender address")
+	}
+	return nil
+}
+
+// NewMsgWriteHookAcknowledgement creates a new MsgWriteHookAcknowledgement instance
+func NewMsgWriteHookAcknowledgement(sender, channelID string, sequence uint64, ack []byte) *MsgWriteHookAcknowledgement {
+	return &MsgWriteHookAcknowledgement{
+		Sender:          sender,
+		ChannelId:       channelID,
+		Sequence:        sequence,
+		Acknowledgement: ack,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgWriteHookAcknowledgement) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errors.Wrap(err, "invalid sender address")
+	}
+	if err := host.ChannelIdentifierValidator(m.ChannelId); err != nil {
+		return errors.Wrap(err, "invalid channel id")
+	}
+	if m.Sequence == 0 {
+		return errors.Wrap(ErrMsgValidation, "packet sequence cannot be 0")
+	}
+	if len(m.Acknowledgement) == 0 {
+		return errors.Wrap(ErrInvalidAck, "acknowledgement cannot be empty")
+	}
+	// The packet has already been received, so its state changes can't be reverted anymore
+	return ValidateRawAcknowledgement(m.Acknowledgement, true)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSubscribeTransfers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errors.Wrap(err, "invalid sender address")
+	}
+	return validateSubscription(m.ChannelId, m.Denom)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUnsubscribeTransfers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errors.Wrap(err, "invalid sender address")
+	}
+	return validateSubscription(m.ChannelId, m.Denom)
+}
+
+// NewMsgRecoverStrandedFunds creates a new MsgRecoverStrandedFunds instance
+func NewMsgRecoverStrandedFunds(sender, intermediateSender, recipient string) *MsgRecoverStrandedFunds {
+	return &MsgRecoverStrandedFunds{
+		Sender:             sender,
+		IntermediateSender: intermediateSender,
+		Recipient:          recipient,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRecoverStrandedFunds) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errors.Wrap(err, "invalid sender address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.IntermediateSender); err != nil {
+		return errors.Wrap(err, "invalid intermediate sender address")
+	}
+	if m.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+			return errors.Wrap(err, "invalid recipient address")
+		}
+	}
+	return nil
+}
+
+func validateSubscription(channelID, denom string) error {
+	if (channelID == "") == (denom == "") {
+		return errors.Wrap(ErrMsgValidation, "exactly one of channel id or denom must be set")
+	}
+	if channelID != "" {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return errors.Wrap(err, "invalid channel id")
+		}
+		return nil
+	}
+	return sdk.ValidateDenom(denom)
+}
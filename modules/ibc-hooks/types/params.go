@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultObserverGasLimit is the default gas available to each observer for a notification
+	DefaultObserverGasLimit uint64 = 100_000
+	// DefaultMaxObservers is the default maximum number of observers of a channel or denom
+	DefaultMaxObservers uint64 = 10
+	// DefaultMaxRelayerFeeBps is the default maximum relayer fee. Relayer fees are disabled by default.
+	DefaultMaxRelayerFeeBps uint32 = 0
+	// MaxBps is the number of basis points of the whole amount
+	MaxBps uint32 = 10_000
+	// DefaultCallbackExpiryDelay is the default time after the timeout of a packet its callback expires
+	DefaultCallbackExpiryDelay = 24 * time.Hour
+	// DefaultMaxCallbacksCheckedPerBlock is the default number of callbacks checked for expiry in each block
+	DefaultMaxCallbacksCheckedPerBlock uint32 = 100
+	// DefaultExpiredCallbackGasLimit is the default gas available to a contract to process an expired callback
+	DefaultExpiredCallbackGasLimit uint64 = 100_000
+)
+
+// NewParams creates a new parameter configuration for the ibc-hooks module
+func NewParams(
+	sendGuards []string,
+	observerGasLimit, maxObservers uint64,
+	maxRelayerFeeBps uint32,
+	callbackExpiryDelay time.Duration,
+	maxCallbacksCheckedPerBlock uint32,
+	expiredCallbackGasLimit uint64,
+) Params {
+	return Params{
+		SendGuards:                  sendGuards,
+		ObserverGasLimit:            observerGasLimit,
+		MaxObservers:                maxObservers,
+		MaxRelayerFeeBps:            maxRelayerFeeBps,
+		CallbackExpiryDelay:         callbackExpiryDelay,
+		MaxCallbacksCheckedPerBlock: maxCallbacksCheckedPerBlock,
+		ExpiredCallbackGasLimit:     expiredCallbackGasLimit,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the ibc-hooks module
+func DefaultParams() Params {
+	return NewParams(
+		nil,
+		DefaultObserverGasLimit,
+		DefaultMaxObservers,
+		DefaultMaxRelayerFeeBps,
+		DefaultCallbackExpiryDelay,
+		DefaultMaxCallbacksCheckedPerBlock,
+		DefaultExpiredCallbackGasLimit,
+	)
+}
+
+// Validate validates all parameters
+func (p Params) Validate() error {
+	if err := validateSendGuards(p.SendGuards); err != nil {
+		return err
+	}
+	if p.MaxObservers > 0 && p.ObserverGasLimit == 0 {
+		return fmt.Errorf("observer gas limit must be positive when observers are enabled")
+	}
+	if p.MaxRelayerFeeBps > MaxBps {
+		return fmt.Errorf("max relayer fee must be at most %d bps: %d", MaxBps, p.MaxRelayerFeeBps)
+	}
+	if p.CallbackExpiryDelay < 0 {
+		return fmt.Errorf("callback expiry delay cannot be negative: %s", p.CallbackExpiryDelay)
+	}
+	if p.MaxCallbacksCheckedPerBlock > 0 && p.ExpiredCallbackGasLimit == 0 {
+		return fmt.Errorf("expired callback gas limit must be positive when callbacks expire")
+	}
+	return nil
+}
+
+func validateSendGuards(sendGuards []string) error {
+	seen := make(map[string]struct{}, len(sendGuards))
+	for _, guard := range sendGuards {
+		if _, err := sdk.AccAddressFromBech32(guard); err != nil {
+			return fmt.Errorf("invalid send guard %s: %w", guard, err)
+		}
+		if _, ok := seen[guard]; ok {
+			return fmt.Errorf("duplicate send guard %s", guard)
+		}
+		seen[guard] = struct{}{}
+	}
+	return nil
+}
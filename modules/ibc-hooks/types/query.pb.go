@@ -0,0 +1,2663 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibchooks/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e013b298a0be2399, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e013b298a0be2399, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryStrandedFundsRequest is the request type for the Query/StrandedFunds RPC method.
+type QueryStrandedFundsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStrandedFundsRequest) Reset()         { *m = QueryStrandedFundsRequest{} }
+func (m *QueryStrandedFundsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStrandedFundsRequest) ProtoMessage()    {}
+func (*QueryStrandedFundsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e013b298a0be2399, []int{2}
+}
+func (m *QueryStrandedFundsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStrandedFundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStrandedFundsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStrandedFundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStrandedFundsRequest.Merge(m, src)
+}
+func (m *QueryStrandedFundsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStrandedFundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStrandedFundsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStrandedFundsRequest proto.InternalMessageInfo
+
+func (m *QueryStrandedFundsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// StrandedBalance is a StrandedFunds record together with the current balance of the address.
+type StrandedBalance struct {
+	StrandedFunds StrandedFunds                            `protobuf:"bytes,1,opt,name=stranded_funds,json=strandedFunds,proto3" json:"stranded_funds"`
+	Balance       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *StrandedBalance) Reset()         { *m = StrandedBalance{} }
+func (m *StrandedBalance) String() string { return proto.CompactTextString(m) }
+func (*StrandedBalance) ProtoMessage()    {}
+func (*StrandedBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e013b298a0be2399, []int{3}
+}
+func (m *StrandedBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StrandedBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StrandedBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StrandedBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StrandedBalance.Merge(m, src)
+}
+func (m *StrandedBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *StrandedBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_StrandedBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StrandedBalance proto.InternalMessageInfo
+
+func (m *StrandedBalance) GetStrandedFunds() StrandedFunds {
+	if m != nil {
+		return m.StrandedFunds
+	}
+	return StrandedFunds{}
+}
+
+func (m *StrandedBalance) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+// QueryStrandedFundsResponse is the response type for the Query/StrandedFunds RPC method.
+type QueryStrandedFundsResponse struct {
+	StrandedBalances []StrandedBalance `protobuf:"bytes,1,rep,name=stranded_balances,json=strandedBalances,proto3" json:"stranded_balances"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStrandedFundsResponse) Reset()         { *m = QueryStrandedFundsResponse{} }
+func (m *QueryStrandedFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStrandedFundsResponse) ProtoMessage()    {}
+func (*QueryStrandedFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e013b298a0be2399, []int{4}
+}
+func (m *QueryStrandedFundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStrandedFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStrandedFundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStrandedFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStrandedFundsResponse.Merge(m, src)
+}
+func (m *QueryStrandedFundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStrandedFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStrandedFundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStrandedFundsResponse proto.InternalMessageInfo
+
+func (m *QueryStrandedFundsResponse) GetStrandedBalances() []StrandedBalance {
+	if m != nil {
+		return m.StrandedBalances
+	}
+	return nil
+}
+
+func (m *QueryStrandedFundsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIntermediateSenderRequest is the request type for the Query/IntermediateSender RPC method.
+type QueryIntermediateSenderRequest struct {
+	// channel_id is the destination channel of the packets.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// original_sender is the sender of the packets on the counterparty chain.
+	OriginalSender string `protobuf:"bytes,2,opt,name=original_sender,json=originalSender,proto3" json:"original_sender,omitempty"`
+	// prefix is the bech32 prefix of the derived address. Defaults to the chain's account prefix.
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (m *QueryIntermediateSenderRequest) Reset()         { *m = QueryIntermediateSenderRequest{} }
+func (m *QueryIntermediateSenderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntermediateSenderRequest) ProtoMessage()    {}
+func (*QueryIntermediateSenderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e013b298a0be2399, []int{5}
+}
+func (m *QueryIntermediateSenderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntermediateSenderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntermediateSenderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntermediateSenderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntermediateSenderRequest.Merge(m, src)
+}
+func (m *QueryIntermediateSenderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntermediateSenderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntermediateSenderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntermediateSenderRequest proto.InternalMessageInfo
+
+func (m *QueryIntermediateSenderRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryIntermediateSenderRequest) GetOriginalSender() string {
+	if m != nil {
+		return m.OriginalSender
+	}
+	return ""
+}
+
+func (m *QueryIntermediateSenderRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+// QueryIntermediateSenderResponse is the response type for the Query/IntermediateSender RPC method.
+type QueryIntermediateSenderResponse struct {
+	// address is the derived intermediate sender.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryIntermediateSenderResponse) Reset()         { *m = QueryIntermediateSenderResponse{} }
+func (m *QueryIntermediateSenderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIntermediateSenderResponse) ProtoMessage()    {}
+func (*QueryIntermediateSenderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e013b298a0be2399, []int{6}
+}
+func (m *QueryIntermediateSenderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntermediateSenderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntermediateSenderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntermediateSenderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntermediateSenderResponse.Merge(m, src)
+}
+func (m *QueryIntermediateSenderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntermediateSenderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntermediateSenderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntermediateSenderResponse proto.InternalMessageInfo
+
+func (m *QueryIntermediateSenderResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryIntermediateSenderOriginRequest is the request type for the Query/IntermediateSenderOrigin RPC method.
+type QueryIntermediateSenderOriginRequest struct {
+	// address is the intermediate sender.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryIntermediateSenderOriginRequest) Reset()         { *m = QueryIntermediateSenderOriginRequest{} }
+func (m *QueryIntermediateSenderOriginRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntermediateSenderOriginRequest) ProtoMessage()    {}
+func (*QueryIntermediateSenderOriginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e013b298a0be2399, []int{7}
+}
+func (m *QueryIntermediateSenderOriginRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntermediateSenderOriginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntermediateSenderOriginRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntermediateSenderOriginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntermediateSenderOriginRequest.Merge(m, src)
+}
+func (m *QueryIntermediateSenderOriginRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntermediateSenderOriginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntermediateSenderOriginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntermediateSenderOriginRequest proto.InternalMessageInfo
+
+func (m *QueryIntermediateSenderOriginRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryIntermediateSenderOriginResponse is the response type for the Query/IntermediateSenderOrigin RPC method.
+type QueryIntermediateSenderOriginResponse struct {
+	Origin IntermediateSenderOrigin `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin"`
+}
+
+func (m *QueryIntermediateSenderOriginResponse) Reset()         { *m = QueryIntermediateSenderOriginResponse{} }
+func (m *QueryIntermediateSenderOriginResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIntermediateSenderOriginResponse) ProtoMessage()    {}
+func (*QueryIntermediateSenderOriginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e013b298a0be2399, []int{8}
+}
+func (m *QueryIntermediateSenderOriginResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntermediateSenderOriginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntermediateSenderOriginResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntermediateSenderOriginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntermediateSenderOriginResponse.Merge(m, src)
+}
+func (m *QueryIntermediateSenderOriginResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntermediateSenderOriginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntermediateSenderOriginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntermediateSenderOriginResponse proto.InternalMessageInfo
+
+func (m *QueryIntermediateSenderOriginResponse) GetOrigin() IntermediateSenderOrigin {
+	if m != nil {
+		return m.Origin
+	}
+	return IntermediateSenderOrigin{}
+}
+
+// QueryPacketCallbacksRequest is the request type for the Query/PacketCallbacks RPC method.
+type QueryPacketCallbacksRequest struct {
+	// contract is the contract notified by the callbacks.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPacketCallbacksRequest) Reset()         { *m = QueryPacketCallbacksRequest{} }
+func (m *QueryPacketCallbacksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketCallbacksRequest) ProtoMessage()    {}
+func (*QueryPacketCallbacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e013b298a0be2399, []int{9}
+}
+func (m *QueryPacketCallbacksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketCallbacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketCallbacksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketCallbacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketCallbacksRequest.Merge(m, src)
+}
+func (m *QueryPacketCallbacksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketCallbacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketCallbacksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketCallbacksRequest proto.InternalMessageInfo
+
+func (m *QueryPacketCallbacksRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryPacketCallbacksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPacketCallbacksResponse is the response type for the Query/PacketCallbacks RPC method.
+type QueryPacketCallbacksResponse struct {
+	Callbacks []PacketCallbackRecord `protobuf:"bytes,1,rep,name=callbacks,proto3" json:"callbacks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPacketCallbacksResponse) Reset()         { *m = QueryPacketCallbacksResponse{} }
+func (m *QueryPacketCallbacksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketCallbacksResponse) ProtoMessage()    {}
+func (*QueryPacketCallbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e013b298a0be2399, []int{10}
+}
+func (m *QueryPacketCallbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketCallbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketCallbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketCallbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketCallbacksResponse.Merge(m, src)
+}
+func (m *QueryPacketCallbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketCallbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketCallbacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketCallbacksResponse proto.InternalMessageInfo
+
+func (m *QueryPacketCallbacksResponse) GetCallbacks() []PacketCallbackRecord {
+	if m != nil {
+		return m.Callbacks
+	}
+	return nil
+}
+
+func (m *QueryPacketCallbacksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "ibchooks.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ibchooks.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryStrandedFundsRequest)(nil), "ibchooks.v1.QueryStrandedFundsRequest")
+	proto.RegisterType((*StrandedBalance)(nil), "ibchooks.v1.StrandedBalance")
+	proto.RegisterType((*QueryStrandedFundsResponse)(nil), "ibchooks.v1.QueryStrandedFundsResponse")
+	proto.RegisterType((*QueryIntermediateSenderRequest)(nil), "ibchooks.v1.QueryIntermediateSenderRequest")
+	proto.RegisterType((*QueryIntermediateSenderResponse)(nil), "ibchooks.v1.QueryIntermediateSenderResponse")
+	proto.RegisterType((*QueryIntermediateSenderOriginRequest)(nil), "ibchooks.v1.QueryIntermediateSenderOriginRequest")
+	proto.RegisterType((*QueryIntermediateSenderOriginResponse)(nil), "ibchooks.v1.QueryIntermediateSenderOriginResponse")
+	proto.RegisterType((*QueryPacketCallbacksRequest)(nil), "ibchooks.v1.QueryPacketCallbacksRequest")
+	proto.RegisterType((*QueryPacketCallbacksResponse)(nil), "ibchooks.v1.QueryPacketCallbacksResponse")
+}
+
+func init() { proto.RegisterFile("ibchooks/v1/query.proto", fileDescriptor_e013b298a0be2399) }
+
+var fileDescriptor_e013b298a0be2399 = []byte{
+	// 868 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x73, 0xdb, 0x44,
+	0x14, 0xc7, 0x2d, 0x97, 0xba, 0xf8, 0x65, 0xda, 0xc0, 0xd6, 0x53, 0x1c, 0x61, 0xe4, 0x54, 0xb4,
+	0x8d, 0x43, 0x89, 0x84, 0x4c, 0x87, 0x03, 0x5c, 0xc0, 0x19, 0x5a, 0x7a, 0x6a, 0x50, 0x6e, 0x5c,
+	0x3c, 0x6b, 0x69, 0xab, 0x68, 0x2c, 0x6b, 0x55, 0xad, 0x9c, 0xa1, 0x93, 0xc9, 0x0c, 0xc3, 0x81,
+	0x33, 0x33, 0x9c, 0x80, 0xff, 0x80, 0x03, 0x27, 0x86, 0x1b, 0x07, 0x6e, 0x39, 0x66, 0xe0, 0xc2,
+	0x09, 0x98, 0x84, 0x3f, 0x81, 0x3f, 0x80, 0xd1, 0xee, 0x93, 0x63, 0xf9, 0xc7, 0x38, 0x30, 0x9c,
+	0x12, 0xbd, 0x7d, 0x3f, 0x3e, 0xef, 0x69, 0xbf, 0x4f, 0x86, 0x57, 0xc2, 0x81, 0x77, 0xc0, 0xf9,
+	0x50, 0xd8, 0x87, 0x8e, 0xfd, 0x6c, 0xcc, 0xd2, 0xe7, 0x56, 0x92, 0xf2, 0x8c, 0x93, 0xb5, 0xe2,
+	0xc0, 0x3a, 0x74, 0xf4, 0x0d, 0x8f, 0x8b, 0x11, 0x17, 0x7d, 0x79, 0x64, 0xab, 0x07, 0xe5, 0xa7,
+	0x37, 0x02, 0x1e, 0x70, 0x65, 0xcf, 0xff, 0x43, 0x6b, 0x2b, 0xe0, 0x3c, 0x88, 0x98, 0x4d, 0x93,
+	0xd0, 0xa6, 0x71, 0xcc, 0x33, 0x9a, 0x85, 0x3c, 0x2e, 0x62, 0xde, 0x50, 0x19, 0xec, 0x01, 0x15,
+	0x4c, 0x15, 0xb5, 0x0f, 0x9d, 0x01, 0xcb, 0xa8, 0x63, 0x27, 0x34, 0x08, 0x63, 0xe9, 0x8c, 0xbe,
+	0xc6, 0xb4, 0x6f, 0xe1, 0xe5, 0xf1, 0xb0, 0x38, 0xd7, 0xa7, 0x1b, 0x98, 0x30, 0xcb, 0x33, 0xb3,
+	0x01, 0xe4, 0xe3, 0x3c, 0xfb, 0x1e, 0x4d, 0xe9, 0x48, 0xb8, 0xec, 0xd9, 0x98, 0x89, 0xcc, 0xfc,
+	0x08, 0x6e, 0x96, 0xac, 0x22, 0xe1, 0xb1, 0x60, 0xc4, 0x81, 0x5a, 0x22, 0x2d, 0x4d, 0x6d, 0x53,
+	0xeb, 0xac, 0x75, 0x6f, 0x5a, 0x53, 0x13, 0xb0, 0x94, 0x73, 0xef, 0x85, 0x93, 0xdf, 0xdb, 0x15,
+	0x17, 0x1d, 0x4d, 0x0f, 0x36, 0x64, 0xa6, 0xfd, 0x2c, 0xa5, 0xb1, 0xcf, 0xfc, 0x87, 0xe3, 0xd8,
+	0x2f, 0xca, 0x90, 0x87, 0x00, 0x17, 0xcd, 0x60, 0xce, 0x7b, 0x16, 0xce, 0x2e, 0xef, 0xc6, 0x52,
+	0xe3, 0xc6, 0x9e, 0xac, 0x3d, 0x1a, 0x30, 0x8c, 0x75, 0xa7, 0x22, 0xcd, 0x9f, 0x35, 0x58, 0x2f,
+	0x0a, 0xf4, 0x68, 0x44, 0x63, 0x8f, 0x91, 0x47, 0x70, 0x43, 0xa0, 0xa9, 0xff, 0x34, 0x2f, 0x8a,
+	0xf9, 0xf5, 0x12, 0x73, 0x09, 0x0b, 0xd1, 0xaf, 0x8b, 0x69, 0x23, 0x61, 0x70, 0x6d, 0xa0, 0x72,
+	0x36, 0xab, 0x9b, 0x57, 0x3a, 0x6b, 0xdd, 0x8d, 0x12, 0x61, 0xc1, 0xb6, 0xcb, 0xc3, 0xb8, 0xf7,
+	0x56, 0x9e, 0xe0, 0xbb, 0x3f, 0xda, 0x9d, 0x20, 0xcc, 0x0e, 0xc6, 0x03, 0xcb, 0xe3, 0x23, 0xbc,
+	0x0a, 0xf8, 0x67, 0x47, 0xf8, 0x43, 0x3b, 0x7b, 0x9e, 0x30, 0x21, 0x03, 0x84, 0x5b, 0xe4, 0x36,
+	0x7f, 0xd4, 0x40, 0x5f, 0x34, 0x29, 0x1c, 0xfd, 0x13, 0x78, 0x79, 0xd2, 0x0e, 0x86, 0xe4, 0x1d,
+	0xe5, 0x3c, 0xad, 0x85, 0x1d, 0xe1, 0x1c, 0xb0, 0xa7, 0x97, 0x44, 0xd9, 0x2c, 0xc8, 0xa3, 0xd2,
+	0xec, 0xab, 0x72, 0x36, 0x5b, 0x2b, 0x67, 0xaf, 0x68, 0x4a, 0xc3, 0xff, 0x4c, 0x03, 0x43, 0x82,
+	0x3f, 0x8e, 0x33, 0x96, 0x8e, 0x98, 0x1f, 0xd2, 0x8c, 0xed, 0xb3, 0xd8, 0x67, 0x69, 0xf1, 0x9e,
+	0x5f, 0x03, 0xf0, 0x0e, 0x68, 0x1c, 0xb3, 0xa8, 0x1f, 0xfa, 0xf2, 0x3d, 0xd4, 0xdd, 0x3a, 0x5a,
+	0x1e, 0xfb, 0x64, 0x0b, 0xd6, 0x79, 0x1a, 0xe6, 0x09, 0xa3, 0xbe, 0x90, 0x81, 0x92, 0xa7, 0xee,
+	0xde, 0x28, 0xcc, 0x2a, 0x1d, 0xb9, 0x05, 0xb5, 0x24, 0x65, 0x4f, 0xc3, 0x4f, 0x9b, 0x57, 0xe4,
+	0x39, 0x3e, 0x99, 0xef, 0x41, 0x7b, 0x29, 0x01, 0xce, 0xaf, 0x09, 0xd7, 0xa8, 0xef, 0xa7, 0x4c,
+	0x08, 0xac, 0x5f, 0x3c, 0x9a, 0xef, 0xc3, 0x9d, 0x25, 0xc1, 0x4f, 0x64, 0xf5, 0xa2, 0x89, 0xe5,
+	0x19, 0x22, 0xb8, 0xbb, 0x22, 0x03, 0x42, 0xec, 0x42, 0x4d, 0x75, 0x84, 0x77, 0xf1, 0x6e, 0xe9,
+	0xcd, 0x2d, 0x0b, 0x2f, 0x14, 0xa5, 0x42, 0xcd, 0x6f, 0x35, 0x78, 0x15, 0xc5, 0xe9, 0x0d, 0x59,
+	0xb6, 0x4b, 0xa3, 0x68, 0x40, 0xbd, 0xe1, 0x44, 0x54, 0x0f, 0xe0, 0x45, 0x8f, 0xc7, 0x59, 0x4a,
+	0xbd, 0x4c, 0x81, 0xf6, 0x9a, 0xbf, 0xfc, 0xb0, 0xd3, 0xc0, 0x37, 0xfb, 0x81, 0x62, 0xde, 0xcf,
+	0xd2, 0x30, 0x0e, 0xdc, 0x89, 0xe7, 0x8c, 0x14, 0xab, 0xff, 0x59, 0x8a, 0xdf, 0x6b, 0xd0, 0x5a,
+	0x4c, 0x87, 0x33, 0xf8, 0x10, 0xea, 0x5e, 0x61, 0xc4, 0x0b, 0x7c, 0x7b, 0x66, 0x8d, 0x4c, 0x07,
+	0xba, 0xcc, 0xe3, 0xa9, 0x8f, 0x23, 0xb8, 0x88, 0xfc, 0xdf, 0xae, 0x6f, 0xf7, 0xef, 0xab, 0x70,
+	0x55, 0x02, 0x93, 0x10, 0x6a, 0x6a, 0x85, 0x91, 0x76, 0x09, 0x68, 0x7e, 0x3f, 0xea, 0x9b, 0xcb,
+	0x1d, 0x54, 0x09, 0xb3, 0xf5, 0xf9, 0xaf, 0x7f, 0x7d, 0x55, 0xbd, 0x45, 0x1a, 0xf9, 0xc2, 0xdd,
+	0x99, 0x6c, 0x5f, 0xb5, 0x15, 0xc9, 0x17, 0x1a, 0x5c, 0x2f, 0xe9, 0x9c, 0xdc, 0x9b, 0xcf, 0xb8,
+	0x68, 0x65, 0xea, 0x5b, 0x2b, 0xfd, 0x10, 0xe0, 0x8e, 0x04, 0x30, 0x48, 0xab, 0x0c, 0x50, 0xde,
+	0x89, 0xe4, 0x1b, 0x0d, 0xc8, 0xfc, 0xbd, 0x23, 0xf7, 0xe7, 0xab, 0x2c, 0x55, 0xb7, 0xfe, 0xe6,
+	0xe5, 0x9c, 0x91, 0x6b, 0x5b, 0x72, 0xbd, 0x4e, 0x6e, 0x97, 0xb9, 0xc2, 0xa9, 0x08, 0x5c, 0x02,
+	0xe4, 0x27, 0x0d, 0x9a, 0xcb, 0x44, 0x41, 0x9c, 0xcb, 0x54, 0x2d, 0x29, 0x58, 0xef, 0xfe, 0x9b,
+	0x10, 0xc4, 0x7d, 0x57, 0xe2, 0x3e, 0x20, 0xdd, 0x95, 0xb8, 0xf6, 0x11, 0xee, 0x83, 0x63, 0x5b,
+	0x29, 0x95, 0x7c, 0xad, 0xc1, 0xfa, 0x8c, 0x0c, 0x48, 0x67, 0xd1, 0xcd, 0x59, 0xa4, 0x63, 0x7d,
+	0xfb, 0x12, 0x9e, 0x08, 0xe9, 0x48, 0xc8, 0xfb, 0x64, 0x7b, 0xf6, 0xb2, 0xe5, 0xee, 0xfd, 0x89,
+	0x68, 0xec, 0xa3, 0x42, 0xee, 0xc7, 0xbd, 0xbd, 0x93, 0x33, 0x43, 0x3b, 0x3d, 0x33, 0xb4, 0x3f,
+	0xcf, 0x0c, 0xed, 0xcb, 0x73, 0xa3, 0x72, 0x7a, 0x6e, 0x54, 0x7e, 0x3b, 0x37, 0x2a, 0x9f, 0xbc,
+	0x33, 0xff, 0xed, 0xca, 0xb3, 0xd2, 0x24, 0x11, 0xf6, 0x88, 0xfb, 0xe3, 0x88, 0x89, 0x52, 0x19,
+	0x47, 0x7d, 0xcf, 0x06, 0x35, 0xf9, 0x83, 0xe2, 0xed, 0x7f, 0x06, 0x00, 0x66, 0xac, 0xce, 0xf9,
+	0x2f, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries all parameters of the ibc-hooks module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// StrandedFunds lists the intermediate senders that kept the funds of failed contract executions,
+	// together with their current balances.
+	StrandedFunds(ctx context.Context, in *QueryStrandedFundsRequest, opts ...grpc.CallOption) (*QueryStrandedFundsResponse, error)
+	// IntermediateSender derives the intermediate sender used to execute the hooks of the packets sent by
+	// original_sender through channel_id.
+	IntermediateSender(ctx context.Context, in *QueryIntermediateSenderRequest, opts ...grpc.CallOption) (*QueryIntermediateSenderResponse, error)
+	// IntermediateSenderOrigin returns the channel and original sender an intermediate sender was derived from.
+	// Intermediate senders are recorded the first time they execute a hook.
+	IntermediateSenderOrigin(ctx context.Context, in *QueryIntermediateSenderOriginRequest, opts ...grpc.CallOption) (*QueryIntermediateSenderOriginResponse, error)
+	// PacketCallbacks lists the packet callbacks registered for a contract.
+	PacketCallbacks(ctx context.Context, in *QueryPacketCallbacksRequest, opts ...grpc.CallOption) (*QueryPacketCallbacksResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibchooks.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StrandedFunds(ctx context.Context, in *QueryStrandedFundsRequest, opts ...grpc.CallOption) (*QueryStrandedFundsResponse, error) {
+	out := new(QueryStrandedFundsResponse)
+	err := c.cc.Invoke(ctx, "/ibchooks.v1.Query/StrandedFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IntermediateSender(ctx context.Context, in *QueryIntermediateSenderRequest, opts ...grpc.CallOption) (*QueryIntermediateSenderResponse, error) {
+	out := new(QueryIntermediateSenderResponse)
+	err := c.cc.Invoke(ctx, "/ibchooks.v1.Query/IntermediateSender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IntermediateSenderOrigin(ctx context.Context, in *QueryIntermediateSenderOriginRequest, opts ...grpc.CallOption) (*QueryIntermediateSenderOriginResponse, error) {
+	out := new(QueryIntermediateSenderOriginResponse)
+	err := c.cc.Invoke(ctx, "/ibchooks.v1.Query/IntermediateSenderOrigin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PacketCallbacks(ctx context.Context, in *QueryPacketCallbacksRequest, opts ...grpc.CallOption) (*QueryPacketCallbacksResponse, error) {
+	out := new(QueryPacketCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/ibchooks.v1.Query/PacketCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries all parameters of the ibc-hooks module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// StrandedFunds lists the intermediate senders that kept the funds of failed contract executions,
+	// together with their current balances.
+	StrandedFunds(context.Context, *QueryStrandedFundsRequest) (*QueryStrandedFundsResponse, error)
+	// IntermediateSender derives the intermediate sender used to execute the hooks of the packets sent by
+	// original_sender through channel_id.
+	IntermediateSender(context.Context, *QueryIntermediateSenderRequest) (*QueryIntermediateSenderResponse, error)
+	// IntermediateSenderOrigin returns the channel and original sender an intermediate sender was derived from.
+	// Intermediate senders are recorded the first time they execute a hook.
+	IntermediateSenderOrigin(context.Context, *QueryIntermediateSenderOriginRequest) (*QueryIntermediateSenderOriginResponse, error)
+	// PacketCallbacks lists the packet callbacks registered for a contract.
+	PacketCallbacks(context.Context, *QueryPacketCallbacksRequest) (*QueryPacketCallbacksResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) StrandedFunds(ctx context.Context, req *QueryStrandedFundsRequest) (*QueryStrandedFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StrandedFunds not implemented")
+}
+func (*UnimplementedQueryServer) IntermediateSender(ctx context.Context, req *QueryIntermediateSenderRequest) (*QueryIntermediateSenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntermediateSender not implemented")
+}
+func (*UnimplementedQueryServer) IntermediateSenderOrigin(ctx context.Context, req *QueryIntermediateSenderOriginRequest) (*QueryIntermediateSenderOriginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntermediateSenderOrigin not implemented")
+}
+func (*UnimplementedQueryServer) PacketCallbacks(ctx context.Context, req *QueryPacketCallbacksRequest) (*QueryPacketCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketCallbacks not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibchooks.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StrandedFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStrandedFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StrandedFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibchooks.v1.Query/StrandedFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StrandedFunds(ctx, req.(*QueryStrandedFundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IntermediateSender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntermediateSenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntermediateSender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibchooks.v1.Query/IntermediateSender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntermediateSender(ctx, req.(*QueryIntermediateSenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IntermediateSenderOrigin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntermediateSenderOriginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntermediateSenderOrigin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibchooks.v1.Query/IntermediateSenderOrigin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntermediateSenderOrigin(ctx, req.(*QueryIntermediateSenderOriginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PacketCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibchooks.v1.Query/PacketCallbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketCallbacks(ctx, req.(*QueryPacketCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibchooks.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "StrandedFunds",
+			Handler:    _Query_StrandedFunds_Handler,
+		},
+		{
+			MethodName: "IntermediateSender",
+			Handler:    _Query_IntermediateSender_Handler,
+		},
+		{
+			MethodName: "IntermediateSenderOrigin",
+			Handler:    _Query_IntermediateSenderOrigin_Handler,
+		},
+		{
+			MethodName: "PacketCallbacks",
+			Handler:    _Query_PacketCallbacks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibchooks/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStrandedFundsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStrandedFundsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStrandedFundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StrandedBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StrandedBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StrandedBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.StrandedFunds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStrandedFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStrandedFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStrandedFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StrandedBalances) > 0 {
+		for iNdEx := len(m.StrandedBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StrandedBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntermediateSenderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntermediateSenderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntermediateSenderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OriginalSender) > 0 {
+		i -= len(m.OriginalSender)
+		copy(dAtA[i:], m.OriginalSender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OriginalSender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntermediateSenderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntermediateSenderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntermediateSenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntermediateSenderOriginRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntermediateSenderOriginRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntermediateSenderOriginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntermediateSenderOriginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntermediateSenderOriginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntermediateSenderOriginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketCallbacksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketCallbacksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketCallbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketCallbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStrandedFundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StrandedBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StrandedFunds.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStrandedFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StrandedBalances) > 0 {
+		for _, e := range m.StrandedBalances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntermediateSenderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OriginalSender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntermediateSenderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntermediateSenderOriginRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntermediateSenderOriginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Origin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPacketCallbacksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPacketCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStrandedFundsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStrandedFundsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStrandedFundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StrandedBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StrandedBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StrandedBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrandedFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StrandedFunds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStrandedFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStrandedFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStrandedFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrandedBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StrandedBalances = append(m.StrandedBalances, StrandedBalance{})
+			if err := m.StrandedBalances[len(m.StrandedBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntermediateSenderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntermediateSenderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntermediateSenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntermediateSenderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntermediateSenderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntermediateSenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntermediateSenderOriginRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntermediateSenderOriginRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntermediateSenderOriginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntermediateSenderOriginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntermediateSenderOriginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntermediateSenderOriginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketCallbacksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketCallbacksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketCallbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketCallbacksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketCallbacksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callbacks = append(m.Callbacks, PacketCallbackRecord{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,356 @@
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/keeper"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v11/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/api"
+	ibcexported "github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+var _ api.IBCModule = (*IBCMiddleware)(nil)
+
+// IBCMiddleware is the IBC v2 (client-ID routed) variant of the wasm hooks middleware. It wraps an ICS-20
+// application and, as the classic middleware does, executes contracts from the memo on receive and notifies
+// the ibc_callback contract of acks and timeouts. Callbacks are keyed by source client ID and sequence.
+// Outgoing packets go through the send guards, and, if observer hooks are set, the observers are notified of the
+// packets received.
+//
+// Unlike the classic middleware, the payload is committed before the middleware sees it, so the ibc_callback key
+// stays in the memo sent, and the packets the send guards add to the memo of are rejected. The packets the contract
+// wants to acknowledge asynchronously fail, as the acknowledgement can't be written later.
+type IBCMiddleware struct {
+	app                 api.IBCModule
+	hooks               ibc_hooks.WasmHooks
+	observers           *ibc_hooks.ObserverHooks
+	bech32PrefixAccAddr string
+}
+
+func NewIBCMiddleware(app api.IBCModule, hooks ibc_hooks.WasmHooks, bech32PrefixAccAddr string) IBCMiddleware {
+	return IBCMiddleware{
+		app:                 app,
+		hooks:               hooks,
+		bech32PrefixAccAddr: bech32PrefixAccAddr,
+	}
+}
+
+// WithObserverHooks returns a copy of the middleware that notifies the observers of the packets it receives, after
+// the wasm hooks have been executed.
+func (im IBCMiddleware) WithObserverHooks(observers ibc_hooks.ObserverHooks) IBCMiddleware {
+	im.observers = &observers
+	return im
+}
+
+func (im IBCMiddleware) OnSendPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		// not an ICS20 packet. Nothing to do
+		return im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer)
+	}
+
+	// The payload is already committed at this point, so unlike the classic middleware the send guards can't
+	// add to the memo and the ibc_callback key can't be removed from it. Returning an error still reverts the send.
+	guardedData := toFungibleTokenPacketData(data)
+	memoUpdated, err := im.hooks.ApplySendGuards(ctx, payload.SourcePort, sourceClient, &guardedData)
+	if err != nil {
+		return err
+	}
+	if memoUpdated {
+		return errorsmod.Wrap(types.ErrSendGuardRejected, "send guards can't add to the memo of IBC v2 packets")
+	}
+
+	if err := im.hooks.ValidateMemo(data.Memo); err != nil {
+		return errorsmod.Wrap(types.ErrMsgValidation, err.Error())
+	}
+	isCallbackRouted, contract, err := ibc_hooks.ParseCallbackMemo(data.Memo)
+	if isCallbackRouted {
+		if err != nil {
+			return errorsmod.Wrap(types.ErrMsgValidation, err.Error())
+		}
+		if err := im.hooks.AuthorizeCallback(ctx, data.Sender, contract); err != nil {
+			return err
+		}
+	}
+
+	if err := im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer); err != nil {
+		return err
+	}
+	if !isCallbackRouted {
+		return nil
+	}
+
+	// The timeout isn't available to the send callback of IBC v2 applications, but core rejects the packets that
+	// time out later than MaxTimeoutDelta after the current block, so the callback is stored with that bound and
+	// expires with it.
+	return im.hooks.StorePacketCallback(ctx, types.PacketCallbackRecord{
+		Contract:         contract,
+		PortId:           payload.SourcePort,
+		ChannelId:        sourceClient,
+		Sequence:         sequence,
+		Sender:           data.Sender,
+		TimeoutTimestamp: uint64(ctx.BlockTime().Add(channeltypesv2.MaxTimeoutDelta).UnixNano()),
+	})
+}
+
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	res := im.onRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	if im.observers == nil || res.Status != channeltypesv2.PacketStatus_Success {
+		return res
+	}
+
+	// The observers are notified of the packet as it was sent, before the wasm hooks updated its receiver
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return res
+	}
+	packet, err := v2ToV1Packet(data, payload, sourceClient, destinationClient, sequence)
+	if err != nil {
+		return res
+	}
+	im.observers.NotifyTransferReceived(ctx, packet)
+	return res
+}
+
+func (im IBCMiddleware) onRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	if !im.hooks.ProperlyConfigured() {
+		// Not configured
+		return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	// Validate the memo
+	if err := im.hooks.ValidateMemo(data.Memo); err != nil {
+		return newErrorResult(ctx, types.ErrMsgValidation, err.Error())
+	}
+	isWasmRouted, contractAddr, msgBytes, err := ibc_hooks.ValidateAndParseMemo(data.Memo, data.Receiver)
+	if !isWasmRouted {
+		return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+	if err != nil {
+		return newErrorResult(ctx, types.ErrMsgValidation, err.Error())
+	}
+	if msgBytes == nil || contractAddr == nil { // This should never happen
+		return newErrorResult(ctx, types.ErrMsgValidation)
+	}
+	keepOnFailure, err := ibc_hooks.ParseOnFailure(data.Memo)
+	if err != nil {
+		return newErrorResult(ctx, types.ErrMsgValidation, err.Error())
+	}
+
+	// The destination client plays the role of the channel when deriving the intermediate sender
+	senderBech32, err := keeper.DeriveIntermediateSender(destinationClient, data.Sender, im.bech32PrefixAccAddr)
+	if err != nil {
+		return newErrorResult(ctx, types.ErrBadSender, fmt.Sprintf("cannot convert sender address %s/%s to bech32: %s", destinationClient, data.Sender, err.Error()))
+	}
+	if err := im.hooks.RecordIntermediateSender(ctx, senderBech32, destinationClient, data.Sender); err != nil {
+		return newErrorResult(ctx, types.ErrBadSender, err.Error())
+	}
+
+	// Hijack the funds to the intermediate sender, re-encoding the payload with its original encoding
+	packet, err := v2ToV1Packet(data, payload, sourceClient, destinationClient, sequence)
+	if err != nil {
+		return newErrorResult(ctx, types.ErrMarshaling, err.Error())
+	}
+	data.Receiver = senderBech32
+	payload.Value, err = marshalPacketData(data, payload.Encoding)
+	if err != nil {
+		return newErrorResult(ctx, types.ErrMarshaling, err.Error())
+	}
+
+	// Execute the receive
+	res := im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	if res.Status != channeltypesv2.PacketStatus_Success {
+		return res
+	}
+
+	amount, ok := sdkmath.NewIntFromString(data.Token.Amount)
+	if !ok {
+		// This should never happen, as it should've been caught in the underlying call to OnRecvPacket,
+		// but returning here for completeness
+		return newErrorResult(ctx, types.ErrInvalidPacket, "Amount is not an int")
+	}
+
+	// The packet's denom is the denom in the sender chain. This needs to be converted to the local denom.
+	denom := ibc_hooks.MustExtractDenomFromPacketOnRecv(packet)
+	funds := sdk.NewCoins(sdk.NewCoin(denom, amount))
+
+	// The relayer fee is paid out of the funds before the contract is executed
+	funds, relayerFee, err := im.hooks.DeductRelayerFee(ctx, data.Memo, senderBech32, relayer, funds)
+	if err != nil {
+		return newErrorResult(ctx, types.ErrInvalidRelayerFee, err.Error())
+	}
+
+	// Execute the contract
+	execMsg := wasmtypes.MsgExecuteContract{
+		Sender:   senderBech32,
+		Contract: contractAddr.String(),
+		Msg:      msgBytes,
+		Funds:    funds,
+	}
+	execCtx, writeCache := ctx, func() {}
+	if keepOnFailure {
+		execCtx, writeCache = ctx.CacheContext()
+	}
+	response, err := im.hooks.ExecWasmMsg(execCtx, &execMsg)
+	if err != nil {
+		if keepOnFailure {
+			ack := im.hooks.KeepFundsAcknowledgement(ctx, err, destinationClient, data.Sender, senderBech32, res.Acknowledgement, relayerFee)
+			return newResult(ack)
+		}
+		return newErrorResult(ctx, types.ErrWasmError, err.Error())
+	}
+	writeCache()
+
+	return contractResult(ctx, response.Data, res.Acknowledgement, relayerFee)
+}
+
+// contractResult returns the result of a packet whose contract execution succeeded. As on classic channels, the
+// contract result is wrapped in a ContractAck unless the contract returns a HookAckResponse with the raw
+// acknowledgement. The middleware can't write the acknowledgements of IBC v2 packets later, so the packets the
+// contract wants to acknowledge asynchronously fail.
+func contractResult(ctx sdk.Context, result, ibcAck []byte, relayerFee string) channeltypesv2.RecvPacketResult {
+	hookAck, ok := ibc_hooks.ParseHookAckResponse(result)
+	if !ok {
+		fullAck := ibc_hooks.ContractAck{ContractResult: result, IbcAck: ibcAck, RelayerFee: relayerFee}
+		bz, err := json.Marshal(fullAck)
+		if err != nil {
+			return newErrorResult(ctx, types.ErrBadResponse, err.Error())
+		}
+		return newResult(channeltypes.NewResultAcknowledgement(bz))
+	}
+
+	if hookAck.Async != nil {
+		return newErrorResult(ctx, types.ErrInvalidAck, "asynchronous acknowledgements aren't supported for IBC v2 packets")
+	}
+	// A failed raw ack reverts the receive, and core replaces it with the IBC v2 error acknowledgement
+	if err := types.ValidateRawAcknowledgement(hookAck.Raw.Ack, hookAck.Raw.Success); err != nil {
+		return newErrorResult(ctx, types.ErrInvalidAck, err.Error())
+	}
+	return newResult(types.NewRawAcknowledgement(hookAck.Raw.Ack, hookAck.Raw.Success))
+}
+
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer); err != nil {
+		return err
+	}
+	return im.hooks.TimeoutCallback(ctx, sourceClient, sequence)
+}
+
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer); err != nil {
+		return err
+	}
+	var memo string
+	if data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding); err == nil {
+		memo = data.Memo
+	}
+	return im.hooks.AcknowledgementCallback(ctx, sourceClient, sequence, acknowledgement, memo)
+}
+
+func newResult(ack ibcexported.Acknowledgement) channeltypesv2.RecvPacketResult {
+	status := channeltypesv2.PacketStatus_Success
+	if !ack.Success() {
+		status = channeltypesv2.PacketStatus_Failure
+	}
+	return channeltypesv2.RecvPacketResult{
+		Status:          status,
+		Acknowledgement: ack.Acknowledgement(),
+	}
+}
+
+func newErrorResult(ctx sdk.Context, err error, errorContexts ...string) channeltypesv2.RecvPacketResult {
+	return channeltypesv2.RecvPacketResult{
+		Status:          channeltypesv2.PacketStatus_Failure,
+		Acknowledgement: ibc_hooks.NewEmitErrorAcknowledgement(ctx, err, errorContexts...).Acknowledgement(),
+	}
+}
+
+func marshalPacketData(data transfertypes.InternalTransferRepresentation, encoding string) ([]byte, error) {
+	if encoding == "" {
+		encoding = transfertypes.EncodingJSON
+	}
+	return transfertypes.MarshalPacketData(toFungibleTokenPacketData(data), transfertypes.V1, encoding)
+}
+
+func toFungibleTokenPacketData(data transfertypes.InternalTransferRepresentation) transfertypes.FungibleTokenPacketData {
+	return transfertypes.FungibleTokenPacketData{
+		Denom:    data.Token.Denom.Path(),
+		Amount:   data.Token.Amount,
+		Sender:   data.Sender,
+		Receiver: data.Receiver,
+		Memo:     data.Memo,
+	}
+}
+
+// v2ToV1Packet builds the classic packet equivalent to the v2 payload, using the client IDs as channels, so the
+// v1 helpers can be reused.
+func v2ToV1Packet(data transfertypes.InternalTransferRepresentation, payload channeltypesv2.Payload, sourceClient, destinationClient string, sequence uint64) (channeltypes.Packet, error) {
+	packetDataBz, err := json.Marshal(toFungibleTokenPacketData(data))
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+
+	return channeltypes.Packet{
+		Sequence:           sequence,
+		SourcePort:         payload.SourcePort,
+		SourceChannel:      sourceClient,
+		DestinationPort:    payload.DestinationPort,
+		DestinationChannel: destinationClient,
+		Data:               packetDataBz,
+		TimeoutHeight:      clienttypes.Height{},
+		TimeoutTimestamp:   0,
+	}, nil
+}
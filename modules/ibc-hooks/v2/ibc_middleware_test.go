@@ -0,0 +1,111 @@
+package v2
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v11"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v11/modules/core/04-channel/v2/types"
+)
+
+func TestV2ToV1Packet(t *testing.T) {
+	payloadValue := transfertypes.FungibleTokenPacketData{
+		Denom:    "transfer/07-tendermint-0/denom",
+		Amount:   "100",
+		Sender:   "sender",
+		Receiver: "receiver",
+		Memo:     "memo",
+	}
+	payloadValueBz, err := transfertypes.MarshalPacketData(payloadValue, transfertypes.V1, transfertypes.EncodingProtobuf)
+	require.NoError(t, err)
+
+	payload := channeltypesv2.Payload{
+		SourcePort:      "sourcePort",
+		DestinationPort: "destinationPort",
+		Version:         transfertypes.V1,
+		Encoding:        transfertypes.EncodingProtobuf,
+		Value:           payloadValueBz,
+	}
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	require.NoError(t, err)
+
+	v1Packet, err := v2ToV1Packet(data, payload, "sourceClient", "destinationClient", 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), v1Packet.Sequence)
+	require.Equal(t, payload.SourcePort, v1Packet.SourcePort)
+	require.Equal(t, "sourceClient", v1Packet.SourceChannel)
+	require.Equal(t, payload.DestinationPort, v1Packet.DestinationPort)
+	require.Equal(t, "destinationClient", v1Packet.DestinationChannel)
+
+	var v1PacketData transfertypes.FungibleTokenPacketData
+	err = json.Unmarshal(v1Packet.Data, &v1PacketData)
+	require.NoError(t, err)
+	require.Equal(t, payloadValue, v1PacketData)
+}
+
+func TestMarshalPacketDataKeepsEncoding(t *testing.T) {
+	for _, encoding := range []string{transfertypes.EncodingJSON, transfertypes.EncodingProtobuf, transfertypes.EncodingABI} {
+		payloadValue := transfertypes.FungibleTokenPacketData{
+			Denom:    "denom",
+			Amount:   "100",
+			Sender:   "sender",
+			Receiver: "receiver",
+			Memo:     "memo",
+		}
+		payloadValueBz, err := transfertypes.MarshalPacketData(payloadValue, transfertypes.V1, encoding)
+		require.NoError(t, err)
+
+		data, err := transfertypes.UnmarshalPacketData(payloadValueBz, transfertypes.V1, encoding)
+		require.NoError(t, err)
+		data.Receiver = "intermediate"
+
+		bz, err := marshalPacketData(data, encoding)
+		require.NoError(t, err)
+		rewritten, err := transfertypes.UnmarshalPacketData(bz, transfertypes.V1, encoding)
+		require.NoError(t, err)
+		require.Equal(t, "intermediate", rewritten.Receiver)
+		require.Equal(t, data.Token, rewritten.Token)
+	}
+}
+
+func TestContractResult(t *testing.T) {
+	ibcAck := channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement()
+	contractAck, err := json.Marshal(ibc_hooks.ContractAck{ContractResult: []byte(`{"count":1}`), IbcAck: ibcAck})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		result    string
+		expStatus channeltypesv2.PacketStatus
+		expAck    []byte
+	}{
+		{"contract ack", `{"count":1}`, channeltypesv2.PacketStatus_Success, channeltypes.NewResultAcknowledgement(contractAck).Acknowledgement()},
+		{"raw ack", `{"ibc_hooks_ack":{"raw":{"ack":"eyJyZXN1bHQiOiJBUT09In0=","success":true}}}`, channeltypesv2.PacketStatus_Success, []byte(`{"result":"AQ=="}`)},
+		{"failed raw ack", `{"ibc_hooks_ack":{"raw":{"ack":"eyJlcnJvciI6ImZhaWxlZCJ9","success":false}}}`, channeltypesv2.PacketStatus_Failure, []byte(`{"error":"failed"}`)},
+		{"invalid raw ack", `{"ibc_hooks_ack":{"raw":{"ack":"eyJyZXN1bHQiOiJBUT09In0=","success":false}}}`, channeltypesv2.PacketStatus_Failure, nil},
+		{"async ack", `{"ibc_hooks_ack":{"async":{}}}`, channeltypesv2.PacketStatus_Failure, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+			res := contractResult(ctx, []byte(tc.result), ibcAck, "")
+			require.Equal(t, tc.expStatus, res.Status)
+			if tc.expAck != nil {
+				require.Equal(t, tc.expAck, res.Acknowledgement)
+				return
+			}
+			require.Equal(t, channeltypes.NewErrorAcknowledgement(types.ErrInvalidAck).Acknowledgement(), res.Acknowledgement)
+		})
+	}
+}
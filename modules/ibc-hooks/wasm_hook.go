@@ -8,11 +8,11 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
 	errors "cosmossdk.io/errors"
-	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/keeper"
 	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v11/types"
+	"github.com/cosmos/ibc-apps/modules/memo"
 	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
@@ -22,12 +22,58 @@ import (
 type ContractAck struct {
 	ContractResult []byte `json:"contract_result"`
 	IbcAck         []byte `json:"ibc_ack"`
+	// RelayerFee is the fee paid to the relayer out of the transferred funds, if any
+	RelayerFee string `json:"relayer_fee,omitempty"`
+}
+
+// HookAckResponse can be returned as the data of the contract execution to control the acknowledgement of the
+// packet. Exactly one of Raw or Async must be set.
+type HookAckResponse struct {
+	IBCHooksAck *HookAck `json:"ibc_hooks_ack"`
+}
+
+type HookAck struct {
+	// Raw is written as the acknowledgement instead of the ContractAck
+	Raw *RawHookAck `json:"raw,omitempty"`
+	// Async defers the acknowledgement until the contract sends a MsgWriteHookAcknowledgement
+	Async *struct{} `json:"async,omitempty"`
+}
+
+type RawHookAck struct {
+	Ack     []byte `json:"ack"`
+	Success bool   `json:"success"`
+}
+
+// ParseHookAckResponse returns the HookAck if the contract result is a valid HookAckResponse
+func ParseHookAckResponse(contractResult []byte) (HookAck, bool) {
+	var response HookAckResponse
+	if err := strictUnmarshal(contractResult, &response); err != nil || response.IBCHooksAck == nil {
+		return HookAck{}, false
+	}
+	hookAck := *response.IBCHooksAck
+	if (hookAck.Raw == nil) == (hookAck.Async == nil) {
+		return HookAck{}, false
+	}
+	return hookAck, true
+}
+
+// RecoverableErrorAck is the payload of the successful acknowledgement returned when the contract execution
+// fails with the "keep" on_failure mode. The funds stay in the intermediate sender and can be recovered later.
+type RecoverableErrorAck struct {
+	ContractError      string `json:"contract_error"`
+	IntermediateSender string `json:"intermediate_sender"`
+	IbcAck             []byte `json:"ibc_ack"`
+	// RelayerFee is the fee paid to the relayer out of the transferred funds, if any
+	RelayerFee string `json:"relayer_fee,omitempty"`
 }
 
 type WasmHooks struct {
 	ContractKeeper      *wasmkeeper.Keeper
 	ibcHooksKeeper      *keeper.Keeper
 	bech32PrefixAccAddr string
+
+	// memoRegistry, when set, validates the whole memo before it is processed
+	memoRegistry *memo.Registry
 }
 
 func NewWasmHooks(ibcHooksKeeper *keeper.Keeper, contractKeeper *wasmkeeper.Keeper, bech32PrefixAccAddr string) WasmHooks {
@@ -38,6 +84,24 @@ func NewWasmHooks(ibcHooksKeeper *keeper.Keeper, contractKeeper *wasmkeeper.Keep
 	}
 }
 
+// WithMemoRegistry returns a copy of the hooks that validates the whole memo of the packets with the registry
+// shared by the middlewares of the stack. The ibc-hooks sections must be registered with RegisterMemoSections.
+func (h WasmHooks) WithMemoRegistry(registry *memo.Registry) WasmHooks {
+	h.memoRegistry = registry
+	return h
+}
+
+// ValidateMemo validates the whole memo with the memo registry, if any
+func (h WasmHooks) ValidateMemo(memo string) error {
+	if h.memoRegistry == nil {
+		return nil
+	}
+	if _, err := h.memoRegistry.Parse(memo); err != nil {
+		return fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, err.Error())
+	}
+	return nil
+}
+
 func (h WasmHooks) ProperlyConfigured() bool {
 	return h.ContractKeeper != nil && h.ibcHooksKeeper != nil
 }
@@ -47,13 +111,16 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, chann
 		// Not configured
 		return im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
 	}
-	isIcs20, data := isIcs20Packet(packet.GetData())
-	if !isIcs20 {
+	data, ok := ParseFundsPacketData(packet.GetData())
+	if !ok {
 		return im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
 	}
 
 	// Validate the memo
-	isWasmRouted, contractAddr, msgBytes, err := ValidateAndParseMemo(data.GetMemo(), data.Receiver)
+	if err := h.ValidateMemo(data.GetMemo()); err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation, err.Error())
+	}
+	isWasmRouted, contractAddr, msgBytes, err := ValidateAndParseMemo(data.GetMemo(), data.GetReceiver())
 	if !isWasmRouted {
 		return im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
 	}
@@ -63,6 +130,10 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, chann
 	if msgBytes == nil || contractAddr == nil { // This should never happen
 		return NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation)
 	}
+	keepOnFailure, err := ParseOnFailure(data.GetMemo())
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation, err.Error())
+	}
 
 	// Calculate the receiver / contract caller based on the packet's channel and sender
 	channel := packet.GetDestChannel()
@@ -71,18 +142,22 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, chann
 	if err != nil {
 		return NewEmitErrorAcknowledgement(ctx, types.ErrBadSender, fmt.Sprintf("cannot convert sender address %s/%s to bech32: %s", channel, sender, err.Error()))
 	}
+	if err := h.ibcHooksKeeper.RecordIntermediateSender(ctx, senderBech32, channel, sender); err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrBadSender, err.Error())
+	}
 
 	// The funds sent on this packet need to be transferred to the intermediary account for the sender.
-	// For this, we override the ICS20 packet's Receiver (essentially hijacking the funds to this new address)
+	// For this, we override the packet's Receiver (essentially hijacking the funds to this new address)
 	// and execute the underlying OnRecvPacket() call (which should eventually land on the transfer app's
 	// relay.go and send the sunds to the intermediary account.
+	// NFTs are the exception: they are received directly by the contract (see NonFungibleTokenPacketData).
 	//
 	// If that succeeds, we make the contract call
-	data.Receiver = senderBech32
-	bz, err := json.Marshal(data)
+	bz, err := data.RecvPacketData(senderBech32)
 	if err != nil {
 		return NewEmitErrorAcknowledgement(ctx, types.ErrMarshaling, err.Error())
 	}
+	receivedPacket := packet
 	packet.Data = bz
 
 	// Execute the receive
@@ -91,16 +166,18 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, chann
 		return ack
 	}
 
-	amount, ok := sdkmath.NewIntFromString(data.GetAmount())
-	if !ok {
+	funds, msgBytes, err := data.ExecuteParams(packet, msgBytes)
+	if err != nil {
 		// This should never happen, as it should've been caught in the underlying call to OnRecvPacket,
 		// but returning here for completeness
-		return NewEmitErrorAcknowledgement(ctx, types.ErrInvalidPacket, "Amount is not an int")
+		return NewEmitErrorAcknowledgement(ctx, types.ErrInvalidPacket, err.Error())
 	}
 
-	// The packet's denom is the denom in the sender chain. This needs to be converted to the local denom.
-	denom := MustExtractDenomFromPacketOnRecv(packet)
-	funds := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	// The relayer fee is paid out of the funds before the contract is executed
+	funds, relayerFee, err := h.DeductRelayerFee(ctx, data.GetMemo(), senderBech32, relayer, funds)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrInvalidRelayerFee, err.Error())
+	}
 
 	// Execute the contract
 	execMsg := wasmtypes.MsgExecuteContract{
@@ -109,13 +186,50 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, chann
 		Msg:      msgBytes,
 		Funds:    funds,
 	}
-	response, err := h.execWasmMsg(ctx, &execMsg)
+	if !keepOnFailure {
+		response, err := h.ExecWasmMsg(ctx, &execMsg)
+		if err != nil {
+			return NewEmitErrorAcknowledgement(ctx, types.ErrWasmError, err.Error())
+		}
+		return h.contractAcknowledgement(ctx, receivedPacket, contractAddr.String(), response.Data, ack.Acknowledgement(), relayerFee)
+	}
+
+	// The contract is executed in a cached context so a failed execution doesn't leave partial state
+	// behind when the receive is acknowledged successfully.
+	cacheCtx, writeCache := ctx.CacheContext()
+	response, err := h.ExecWasmMsg(cacheCtx, &execMsg)
 	if err != nil {
-		return NewEmitErrorAcknowledgement(ctx, types.ErrWasmError, err.Error())
+		return h.KeepFundsAcknowledgement(ctx, err, channel, sender, senderBech32, ack.Acknowledgement(), relayerFee)
 	}
+	writeCache()
+	return h.contractAcknowledgement(ctx, receivedPacket, contractAddr.String(), response.Data, ack.Acknowledgement(), relayerFee)
+}
 
-	fullAck := ContractAck{ContractResult: response.Data, IbcAck: ack.Acknowledgement()}
-	bz, err = json.Marshal(fullAck)
+// contractAcknowledgement returns the acknowledgement for a packet whose contract execution succeeded. By default
+// the contract result is wrapped in a ContractAck, but the contract can return a HookAckResponse to provide the
+// raw acknowledgement or to acknowledge the packet asynchronously.
+func (h WasmHooks) contractAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, contract string, contractResult, ibcAck []byte, relayerFee string) ibcexported.Acknowledgement {
+	hookAck, ok := ParseHookAckResponse(contractResult)
+	if !ok {
+		return newContractAck(ctx, contractResult, ibcAck, relayerFee)
+	}
+
+	if hookAck.Async != nil {
+		// A nil acknowledgement tells core IBC that it will be written later with MsgWriteHookAcknowledgement
+		h.ibcHooksKeeper.StorePendingAcknowledgement(ctx, packet, contract)
+		return nil
+	}
+
+	// A failed raw ack reverts the receive, so the sender chain must see the same outcome as this chain
+	if err := types.ValidateRawAcknowledgement(hookAck.Raw.Ack, hookAck.Raw.Success); err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrInvalidAck, err.Error())
+	}
+	return types.NewRawAcknowledgement(hookAck.Raw.Ack, hookAck.Raw.Success)
+}
+
+func newContractAck(ctx sdk.Context, contractResult, ibcAck []byte, relayerFee string) ibcexported.Acknowledgement {
+	fullAck := ContractAck{ContractResult: contractResult, IbcAck: ibcAck, RelayerFee: relayerFee}
+	bz, err := json.Marshal(fullAck)
 	if err != nil {
 		return NewEmitErrorAcknowledgement(ctx, types.ErrBadResponse, err.Error())
 	}
@@ -123,7 +237,54 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, chann
 	return channeltypes.NewResultAcknowledgement(bz)
 }
 
-func (h WasmHooks) execWasmMsg(ctx sdk.Context, execMsg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
+// KeepFundsAcknowledgement records the funds kept by the intermediate sender after a failed contract execution
+// and returns a successful acknowledgement with a recoverable error payload. As with error acks, only the
+// deterministic ABCI code of the error is included in the ack. The details are emitted as an event.
+func (h WasmHooks) KeepFundsAcknowledgement(ctx sdk.Context, execErr error, channel, originalSender, intermediateSender string, ibcAck []byte, relayerFee string) ibcexported.Acknowledgement {
+	h.ibcHooksKeeper.SetStrandedFunds(ctx, types.StrandedFunds{
+		Address:        intermediateSender,
+		ChannelId:      channel,
+		OriginalSender: originalSender,
+	})
+	NewEmitErrorAcknowledgement(ctx, types.ErrWasmError, execErr.Error())
+
+	_, code, _ := errors.ABCIInfo(execErr, false)
+	bz, err := json.Marshal(RecoverableErrorAck{
+		ContractError:      fmt.Sprintf("ABCI code: %d: %s", code, types.ErrWasmError.Error()),
+		IntermediateSender: intermediateSender,
+		IbcAck:             ibcAck,
+		RelayerFee:         relayerFee,
+	})
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrBadResponse, err.Error())
+	}
+	return channeltypes.NewResultAcknowledgement(bz)
+}
+
+// DeductRelayerFee pays the relayer fee set in the wasm memo, if any, out of the funds received by the intermediate
+// sender. It returns the remaining funds and the fee paid, as a coin string, or an empty string if there is no fee.
+func (h WasmHooks) DeductRelayerFee(ctx sdk.Context, memo, intermediateSender string, relayer sdk.AccAddress, funds sdk.Coins) (sdk.Coins, string, error) {
+	feeAmount, found, err := ParseRelayerFee(memo)
+	if err != nil || !found {
+		return funds, "", err
+	}
+	if len(funds) != 1 {
+		return funds, "", fmt.Errorf("the relayer fee can only be paid out of fungible tokens")
+	}
+
+	fee := sdk.NewCoin(funds[0].Denom, feeAmount)
+	senderAddr, err := sdk.AccAddressFromBech32(intermediateSender)
+	if err != nil {
+		return funds, "", err
+	}
+	if err := h.ibcHooksKeeper.PayRelayerFee(ctx, senderAddr, relayer, fee, funds[0].Amount); err != nil {
+		return funds, "", err
+	}
+	return funds.Sub(fee), fee.String(), nil
+}
+
+// ExecWasmMsg validates and executes the contract call through the wasm msg server
+func (h WasmHooks) ExecWasmMsg(ctx sdk.Context, execMsg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
 	if err := execMsg.ValidateBasic(); err != nil {
 		return nil, fmt.Errorf(types.ErrBadExecutionMsg, err.Error())
 	}
@@ -131,6 +292,8 @@ func (h WasmHooks) execWasmMsg(ctx sdk.Context, execMsg *wasmtypes.MsgExecuteCon
 	return wasmMsgServer.ExecuteContract(sdk.WrapSDKContext(ctx), execMsg)
 }
 
+// isIcs20Packet decodes the packet data as ICS-20 packet data. The decoding of FungibleTokenPacketData rejects unknown
+// fields, so other JSON packets (e.g. interchain accounts packets) aren't identified as ICS-20 packets.
 func isIcs20Packet(data []byte) (isIcs20 bool, ics20data transfertypes.FungibleTokenPacketData) {
 	var packetdata transfertypes.FungibleTokenPacketData
 	if err := json.Unmarshal(data, &packetdata); err != nil {
@@ -139,134 +302,191 @@ func isIcs20Packet(data []byte) (isIcs20 bool, ics20data transfertypes.FungibleT
 	return true, packetdata
 }
 
-// jsonStringHasKey parses the memo as a json object and checks if it contains the key.
-func jsonStringHasKey(memo, key string) (found bool, jsonObject map[string]interface{}) {
-	jsonObject = make(map[string]interface{})
-
-	// If there is no memo, the packet was either sent with an earlier version of IBC, or the memo was
-	// intentionally left blank. Nothing to do here. Ignore the packet and pass it down the stack.
-	if len(memo) == 0 {
-		return false, jsonObject
+func (h WasmHooks) SendPacketOverride(i ICS4Middleware, ctx sdk.Context, sourcePort string, sourceChannel string, timeoutHeight ibcclienttypes.Height, timeoutTimestamp uint64, data []byte) (sequence uint64, err error) {
+	isIcs20, ics20data := isIcs20Packet(data)
+	if !isIcs20 {
+		return i.channel.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data) // continue
 	}
 
-	// the jsonObject must be a valid JSON object
-	err := json.Unmarshal([]byte(memo), &jsonObject)
+	// The send guards can reject the packet or add to its memo before any other processing
+	memoUpdated, err := h.ApplySendGuards(ctx, sourcePort, sourceChannel, &ics20data)
 	if err != nil {
-		return false, jsonObject
+		return 0, err
 	}
-
-	// If the key doesn't exist, there's nothing to do on this hook. Continue by passing the packet
-	// down the stack
-	_, ok := jsonObject[key]
-	return ok, jsonObject
-}
-
-func ValidateAndParseMemo(memo string, receiver string) (isWasmRouted bool, contractAddr sdk.AccAddress, msgBytes []byte, err error) {
-	isWasmRouted, metadata := jsonStringHasKey(memo, "wasm")
-	if !isWasmRouted {
-		return isWasmRouted, sdk.AccAddress{}, nil, nil
+	if memoUpdated {
+		data, err = json.Marshal(ics20data)
+		if err != nil {
+			return 0, errors.Wrap(err, "ics20data marshall error")
+		}
 	}
 
-	wasmRaw := metadata["wasm"]
-
-	// Make sure the wasm key is a map. If it isn't, ignore this packet
-	wasm, ok := wasmRaw.(map[string]interface{})
-	if !ok {
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, "wasm metadata is not a valid JSON map object")
+	if err := h.ValidateMemo(ics20data.GetMemo()); err != nil {
+		return 0, errors.Wrap(types.ErrMsgValidation, err.Error())
 	}
 
-	contract, ok := wasm["contract"].(string)
-	if !ok {
-		// The tokens will be returned
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `Could not find key wasm["contract"]`)
+	isCallbackRouted, contract, err := ParseCallbackMemo(ics20data.GetMemo())
+	if !isCallbackRouted {
+		return i.channel.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data) // continue
 	}
 
-	contractAddr, err = sdk.AccAddressFromBech32(contract)
+	// Make sure the callback contract is valid and that the sender is allowed to use it before sending the packet
 	if err != nil {
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["contract"] is not a valid bech32 address`)
+		return 0, errors.Wrap(types.ErrMsgValidation, err.Error())
 	}
-
-	if contract != receiver {
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["contract"] should be the same as the receiver of the packet`)
+	if err := h.AuthorizeCallback(ctx, ics20data.GetSender(), contract); err != nil {
+		return 0, err
 	}
 
-	if wasm["msg"] == nil {
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `Could not find key wasm["msg"]`)
+	// The callback is stored in the keeper (at the end of this function), so the ibc_callback key is removed from
+	// the memo. If it was the only key, the packet is sent without a memo, so that receiver chains on old versions
+	// of IBC are able to process it.
+	ics20data.Memo, err = parseMemo(ics20data.GetMemo()).Without(types.IBCCallbackKey)
+	if err != nil {
+		return 0, errors.Wrap(err, "ibc_callback marshall error")
 	}
-
-	_, ok = wasm["msg"].(map[string]interface{})
-	if !ok {
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["msg"] is not a map object`)
+	dataBytes, err := json.Marshal(ics20data)
+	if err != nil {
+		return 0, errors.Wrap(err, "ics20data marshall error")
 	}
 
-	// Get the message string by serializing the map
-	msgBytes, err = json.Marshal(wasm["msg"])
+	seq, err := i.channel.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, dataBytes)
 	if err != nil {
-		// The tokens will be returned
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, err.Error())
+		return 0, err
 	}
 
-	return isWasmRouted, contractAddr, msgBytes, nil
+	if err := h.ibcHooksKeeper.StorePacketCallback(ctx, types.PacketCallbackRecord{
+		Contract:              contract,
+		PortId:                sourcePort,
+		ChannelId:             sourceChannel,
+		Sequence:              seq,
+		Sender:                ics20data.Sender,
+		TimeoutTimestamp:      timeoutTimestamp,
+		TimeoutRevisionNumber: timeoutHeight.RevisionNumber,
+		TimeoutRevisionHeight: timeoutHeight.RevisionHeight,
+	}); err != nil {
+		return 0, err
+	}
+	return seq, nil
 }
 
-func (h WasmHooks) SendPacketOverride(i ICS4Middleware, ctx sdk.Context, sourcePort string, sourceChannel string, timeoutHeight ibcclienttypes.Height, timeoutTimestamp uint64, data []byte) (sequence uint64, err error) {
-	isIcs20, ics20data := isIcs20Packet(data)
-	if !isIcs20 {
-		return i.channel.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data) // continue
-	}
+// SendGuardSudoMsg is the sudo message sent to the send guard contracts for every outgoing ICS-20 packet
+type SendGuardSudoMsg struct {
+	IBCSendGuard IBCSendGuard `json:"ibc_send_guard"`
+}
 
-	isCallbackRouted, metadata := jsonStringHasKey(ics20data.GetMemo(), types.IBCCallbackKey)
-	if !isCallbackRouted {
-		return i.channel.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data) // continue
-	}
+type IBCSendGuard struct {
+	SourcePort    string `json:"source_port"`
+	SourceChannel string `json:"source_channel"`
+	Denom         string `json:"denom"`
+	Amount        string `json:"amount"`
+	Sender        string `json:"sender"`
+	Receiver      string `json:"receiver"`
+	Memo          string `json:"memo"`
+}
 
-	// We remove the meta.callback provides instructions for post-send processing. This instruction are saved
-	// in the keeper (at the end of this function), and  at the end - it is set as  as it has already been processed.
+// SendGuardResponse is the data returned by a send guard. An empty response approves the packet.
+type SendGuardResponse struct {
+	// Reject rejects the packet, failing the transaction
+	Reject *SendGuardReject `json:"reject,omitempty"`
+	// AddMemo is a JSON object whose keys are added to the packet's memo. Existing keys can't be overwritten.
+	AddMemo json.RawMessage `json:"add_memo,omitempty"`
+}
 
-	// If the only available key in the memo is the callback, we should remove the memo
-	// from the data completely so the packet is sent without it.
-	// This way receiver chains that are on old versions of IBC will be able to process the packet
-	callbackRaw := metadata[types.IBCCallbackKey] // This will be used later.
-	delete(metadata, types.IBCCallbackKey)
-	bzMetadata, err := json.Marshal(metadata)
-	if err != nil {
-		return 0, errors.Wrap(err, "ibc_callback marshall error")
+type SendGuardReject struct {
+	Reason string `json:"reason"`
+}
+
+// ApplySendGuards calls the send guards registered by governance, in order, with the outgoing ICS-20 packet.
+// Each guard sees the memo as updated by the previous ones. It returns true if the memo has been updated.
+// For IBC v2 packets, the source channel is the source client ID. When guards are set, packets with invalid data
+// are rejected, as the guards can't reason about them.
+func (h WasmHooks) ApplySendGuards(ctx sdk.Context, sourcePort, sourceChannel string, data *transfertypes.FungibleTokenPacketData) (bool, error) {
+	if h.ibcHooksKeeper == nil {
+		return false, nil
 	}
-	stringMetadata := string(bzMetadata)
-	if stringMetadata == "{}" {
-		ics20data.Memo = ""
-	} else {
-		ics20data.Memo = stringMetadata
+	guards := h.ibcHooksKeeper.GetSendGuards(ctx)
+	if len(guards) == 0 {
+		return false, nil
 	}
-	dataBytes, err := json.Marshal(ics20data)
-	if err != nil {
-		return 0, errors.Wrap(err, "ics20data marshall error")
+	if err := data.ValidateBasic(); err != nil {
+		return false, errors.Wrapf(types.ErrSendGuardRejected, "invalid packet data: %s", err)
+	}
+	if h.ContractKeeper == nil {
+		return false, errors.Wrap(types.ErrSendGuardRejected, "send guards are set but the contract keeper is not configured")
 	}
 
-	seq, err := i.channel.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, dataBytes)
-	if err != nil {
-		return 0, err
+	memoUpdated := false
+	for _, guard := range guards {
+		guardAddr, err := sdk.AccAddressFromBech32(guard)
+		if err != nil {
+			return false, errors.Wrap(types.ErrSendGuardRejected, err.Error())
+		}
+		sudoMsg, err := json.Marshal(SendGuardSudoMsg{
+			IBCSendGuard: IBCSendGuard{
+				SourcePort:    sourcePort,
+				SourceChannel: sourceChannel,
+				Denom:         data.Denom,
+				Amount:        data.Amount,
+				Sender:        data.Sender,
+				Receiver:      data.Receiver,
+				Memo:          data.Memo,
+			},
+		})
+		if err != nil {
+			return false, errors.Wrap(err, "send guard sudo marshall error")
+		}
+
+		res, err := h.ContractKeeper.Sudo(ctx, guardAddr, sudoMsg)
+		if err != nil {
+			return false, errors.Wrapf(types.ErrSendGuardRejected, "send guard %s failed: %s", guard, err.Error())
+		}
+		if len(res) == 0 {
+			continue
+		}
+
+		var response SendGuardResponse
+		if err := json.Unmarshal(res, &response); err != nil {
+			return false, errors.Wrapf(types.ErrSendGuardRejected, "invalid response from send guard %s: %s", guard, err.Error())
+		}
+		if response.Reject != nil {
+			return false, errors.Wrapf(types.ErrSendGuardRejected, "rejected by %s: %s", guard, response.Reject.Reason)
+		}
+		if len(response.AddMemo) > 0 {
+			data.Memo, err = addToMemo(data.Memo, response.AddMemo)
+			if err != nil {
+				return false, errors.Wrapf(types.ErrSendGuardRejected, "send guard %s cannot add to the memo: %s", guard, err.Error())
+			}
+			memoUpdated = true
+		}
 	}
+	return memoUpdated, nil
+}
 
-	// Make sure the callback contract is a string and a valid bech32 addr. If it isn't, ignore this packet
-	contract, ok := callbackRaw.(string)
-	if !ok {
-		return 0, nil
+// addToMemo adds the keys of the JSON object to the memo, which must be empty or a JSON object
+func addToMemo(memo string, add json.RawMessage) (string, error) {
+	var toAdd map[string]json.RawMessage
+	if err := json.Unmarshal(add, &toAdd); err != nil {
+		return "", fmt.Errorf("add_memo is not a JSON object: %w", err)
 	}
-	_, err = sdk.AccAddressFromBech32(contract)
-	if err != nil {
-		return 0, nil
+
+	merged := make(map[string]json.RawMessage)
+	if memo != "" {
+		if err := json.Unmarshal([]byte(memo), &merged); err != nil {
+			return "", fmt.Errorf("the packet memo is not a JSON object: %w", err)
+		}
+	}
+	for key, value := range toAdd {
+		if _, ok := merged[key]; ok {
+			return "", fmt.Errorf("memo key %q already exists", key)
+		}
+		merged[key] = value
 	}
 
-	h.ibcHooksKeeper.StorePacketCallback(ctx, sourceChannel, seq, contract)
-	return seq, nil
+	bz, err := json.Marshal(merged)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
 }
 
 func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Context, channelVersion string, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
@@ -275,12 +495,20 @@ func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Con
 		return err
 	}
 
+	_, data := isIcs20Packet(packet.GetData())
+	return h.AcknowledgementCallback(ctx, packet.GetSourceChannel(), packet.GetSequence(), acknowledgement, data.Memo)
+}
+
+// AcknowledgementCallback notifies the contract registered for the packet, if any, that the ack has been received.
+// For IBC v2 packets, the channel is the source client ID. The memo of the packet, if any, is used to describe the
+// failure of an error acknowledgement.
+func (h WasmHooks) AcknowledgementCallback(ctx sdk.Context, channel string, sequence uint64, acknowledgement []byte, memo string) error {
 	if !h.ProperlyConfigured() {
 		// Not configured. Return from the underlying implementation
 		return nil
 	}
 
-	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, channel, sequence)
 	if contract == "" {
 		// No callback configured
 		return nil
@@ -291,9 +519,15 @@ func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Con
 		return errors.Wrap(err, "Ack callback error") // The callback configured is not a bech32. Error out
 	}
 
-	success := "false"
-	if !IsJSONAckError(acknowledgement) {
-		success = "true"
+	success := "true"
+	failure := ""
+	if IsJSONAckError(acknowledgement) {
+		success = "false"
+		failureJSON, err := json.Marshal(ParseAckFailure(acknowledgement, channel, memo))
+		if err != nil {
+			return err
+		}
+		failure = fmt.Sprintf(`, "failure": %s`, failureJSON)
 	}
 
 	// Notify the sender that the ack has been received
@@ -304,16 +538,15 @@ func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Con
 	}
 
 	sudoMsg := []byte(fmt.Sprintf(
-		`{"ibc_lifecycle_complete": {"ibc_ack": {"channel": "%s", "sequence": %d, "ack": %s, "success": %s}}}`,
-		packet.SourceChannel, packet.Sequence, ackAsJSON, success))
+		`{"ibc_lifecycle_complete": {"ibc_ack": {"channel": "%s", "sequence": %d, "ack": %s, "success": %s%s}}}`,
+		channel, sequence, ackAsJSON, success, failure))
 	_, err = h.ContractKeeper.Sudo(ctx, contractAddr, sudoMsg)
 	if err != nil {
 		// error processing the callback
 		// ToDo: Open Question: Should we also delete the callback here?
 		return errors.Wrap(err, "Ack callback error")
 	}
-	h.ibcHooksKeeper.DeletePacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
-	return nil
+	return h.ibcHooksKeeper.DeletePacketCallback(ctx, channel, sequence)
 }
 
 func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) error {
@@ -322,12 +555,18 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, ch
 		return err
 	}
 
+	return h.TimeoutCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+}
+
+// TimeoutCallback notifies the contract registered for the packet, if any, that the packet has timed out.
+// For IBC v2 packets, the channel is the source client ID.
+func (h WasmHooks) TimeoutCallback(ctx sdk.Context, channel string, sequence uint64) error {
 	if !h.ProperlyConfigured() {
 		// Not configured. Return from the underlying implementation
 		return nil
 	}
 
-	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, channel, sequence)
 	if contract == "" {
 		// No callback configured
 		return nil
@@ -340,7 +579,7 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, ch
 
 	sudoMsg := []byte(fmt.Sprintf(
 		`{"ibc_lifecycle_complete": {"ibc_timeout": {"channel": "%s", "sequence": %d}}}`,
-		packet.SourceChannel, packet.Sequence))
+		channel, sequence))
 	_, err = h.ContractKeeper.Sudo(ctx, contractAddr, sudoMsg)
 	if err != nil {
 		// error processing the callback. This could be because the contract doesn't implement the message type to
@@ -355,8 +594,7 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, ch
 			),
 		})
 	}
-	h.ibcHooksKeeper.DeletePacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
-	return nil
+	return h.ibcHooksKeeper.DeletePacketCallback(ctx, channel, sequence)
 }
 
 // NewEmitErrorAcknowledgement creates a new error acknowledgement after having emitted an event with the
@@ -385,11 +623,7 @@ func NewEmitErrorAcknowledgement(ctx sdk.Context, err error, errorContexts ...st
 // IsJSONAckError checks an IBC acknowledgement to see if it's an error.
 // This is a replacement for ack.Success() which is currently not working on some circumstances
 func IsJSONAckError(acknowledgement []byte) bool {
-	var ackErr channeltypes.Acknowledgement_Error
-	if err := json.Unmarshal(acknowledgement, &ackErr); err == nil && len(ackErr.Error) > 0 {
-		return true
-	}
-	return false
+	return types.IsJSONAckError(acknowledgement)
 }
 
 // MustExtractDenomFromPacketOnRecv takes a packet with a valid ICS20 token data in the Data field and returns the
@@ -401,25 +635,33 @@ func MustExtractDenomFromPacketOnRecv(packet ibcexported.PacketI) string {
 		panic("unable to unmarshal ICS20 packet data")
 	}
 
-	var denom string
-	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
-		// if we receive back a token, that was originally sent from "this" chain, then we need to remove
-		// prefix added by the sender chain: port/channel/base_denom -> base_denom.
-		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
-		unprefixedDenom := data.Denom[len(voucherPrefix):]
-
-		// coin denomination used in sending from the escrow address
-		denom = unprefixedDenom
+	return localDenomOnRecv(packet, data.Denom)
+}
 
-		// The denomination used to send the coins is either the native denom or the hash of the path
-		// if the denomination is not native.
-		denomTrace := transfertypes.ExtractDenomFromPath(unprefixedDenom)
-		if !denomTrace.IsNative() {
-			denom = denomTrace.IBCDenom()
-		}
-	} else {
-		prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.Denom
-		denom = transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+// AuthorizeCallback checks that the callback contract exists and that the sender is allowed to set it as the
+// ibc_callback of its packets: the sender must be the contract itself or have been authorized by it.
+func (h WasmHooks) AuthorizeCallback(ctx sdk.Context, sender, contract string) error {
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return errors.Wrap(types.ErrMsgValidation, err.Error())
+	}
+	if h.ContractKeeper != nil && !h.ContractKeeper.HasContractInfo(ctx, contractAddr) {
+		return errors.Wrapf(types.ErrMsgValidation, "ibc_callback contract %s does not exist", contract)
 	}
-	return denom
+	if !h.ibcHooksKeeper.IsCallbackAuthorized(ctx, contract, sender) {
+		return errors.Wrapf(types.ErrUnauthorizedCallback, "%s is not authorized to use %s as ibc_callback", sender, contract)
+	}
+	return nil
+}
+
+// RecordIntermediateSender records the channel and original sender an intermediate sender was derived from.
+// For IBC v2 packets, the channel is the destination client ID.
+func (h WasmHooks) RecordIntermediateSender(ctx sdk.Context, address, channel, originalSender string) error {
+	return h.ibcHooksKeeper.RecordIntermediateSender(ctx, address, channel, originalSender)
+}
+
+// StorePacketCallback registers the contract that will be notified of the ack or timeout of the packet.
+// For IBC v2 packets, the channel is the source client ID.
+func (h WasmHooks) StorePacketCallback(ctx sdk.Context, record types.PacketCallbackRecord) error {
+	return h.ibcHooksKeeper.StorePacketCallback(ctx, record)
 }
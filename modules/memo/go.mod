@@ -0,0 +1,3 @@
+module github.com/cosmos/ibc-apps/modules/memo
+
+go 1.21
@@ -0,0 +1,203 @@
+// Package memo provides a registry of the top-level keys of the ICS-20 memo. Middlewares that read the memo
+// (e.g. ibc-hooks with "wasm" and "ibc_callback", packet-forward-middleware with "forward" or async-icq with
+// "memo") register the keys they own, so the memo is parsed and validated once for the whole stack, conflicting
+// registrations are detected when the app is wired, and each middleware gets typed access to its section.
+//
+// The package is its own Go module and only depends on the standard library, so it can be shared by modules built
+// against different versions of ibc-go.
+package memo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// ValidateFn validates the raw JSON value of a memo section
+type ValidateFn func(section json.RawMessage) error
+
+type section struct {
+	owner    string
+	validate ValidateFn
+}
+
+// Config defines how strictly memos are validated
+type Config struct {
+	// RejectUnknownKeys rejects memos with top-level keys that no middleware registered
+	RejectUnknownKeys bool
+	// RejectDuplicateKeys rejects memos that repeat a top-level key. By default the last value is used, as
+	// encoding/json does.
+	RejectDuplicateKeys bool
+}
+
+// Registry holds the memo sections registered by the middlewares of an IBC stack
+type Registry struct {
+	config   Config
+	sections map[string]section
+}
+
+func NewRegistry(config Config) *Registry {
+	return &Registry{
+		config:   config,
+		sections: make(map[string]section),
+	}
+}
+
+// Register registers the top-level key owned by a middleware. validate can be nil. Registering the same key twice
+// is an error, as two middlewares would interpret the same section.
+func (r *Registry) Register(key, owner string, validate ValidateFn) error {
+	if key == "" {
+		return fmt.Errorf("memo key cannot be empty")
+	}
+	if existing, ok := r.sections[key]; ok {
+		return fmt.Errorf("memo key %q registered by %s is already registered by %s", key, owner, existing.owner)
+	}
+	r.sections[key] = section{owner: owner, validate: validate}
+	return nil
+}
+
+// Keys returns the registered keys in lexicographic order
+func (r *Registry) Keys() []string {
+	keys := make([]string, 0, len(r.sections))
+	for key := range r.sections {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Owner returns the middleware that registered the key
+func (r *Registry) Owner(key string) (string, bool) {
+	s, ok := r.sections[key]
+	return s.owner, ok
+}
+
+// Parse parses and validates the whole memo. Memos that are not JSON objects (including empty memos) are not
+// routed to any middleware, so they are returned as an empty Memo without error.
+func (r *Registry) Parse(memo string) (Memo, error) {
+	sections, isObject, err := decodeObject(memo, r.config.RejectDuplicateKeys)
+	if !isObject {
+		return Memo{}, nil
+	}
+	if err != nil {
+		return Memo{}, err
+	}
+
+	for key, value := range sections {
+		s, registered := r.sections[key]
+		if !registered {
+			if r.config.RejectUnknownKeys {
+				return Memo{}, fmt.Errorf("unknown memo key %q", key)
+			}
+			continue
+		}
+		if s.validate != nil {
+			if err := s.validate(value); err != nil {
+				return Memo{}, fmt.Errorf("invalid memo key %q (%s): %w", key, s.owner, err)
+			}
+		}
+	}
+
+	return Memo{sections: sections}, nil
+}
+
+// Parse parses the memo without any registered section, for middlewares that only need typed access to their own
+// section. Memos that are not JSON objects are returned as an empty Memo without error.
+func Parse(memo string) (Memo, error) {
+	sections, isObject, err := decodeObject(memo, false)
+	if !isObject || err != nil {
+		return Memo{}, err
+	}
+	return Memo{sections: sections}, nil
+}
+
+// decodeObject decodes the top-level keys of a JSON object. isObject is false if the memo is not a JSON object.
+func decodeObject(memo string, rejectDuplicates bool) (sections map[string]json.RawMessage, isObject bool, err error) {
+	trimmed := bytes.TrimSpace([]byte(memo))
+	if len(trimmed) == 0 || trimmed[0] != '{' || !json.Valid(trimmed) {
+		return nil, false, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(trimmed))
+	if _, err := dec.Token(); err != nil { // opening brace
+		return nil, true, err
+	}
+
+	sections = make(map[string]json.RawMessage)
+	for dec.More() {
+		keyToken, err := dec.Token()
+		if err != nil {
+			return nil, true, err
+		}
+		key, ok := keyToken.(string)
+		if !ok {
+			return nil, true, fmt.Errorf("invalid memo key %v", keyToken)
+		}
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, true, err
+		}
+		if _, seen := sections[key]; seen && rejectDuplicates {
+			return nil, true, fmt.Errorf("duplicate memo key %q", key)
+		}
+		sections[key] = value
+	}
+
+	if _, err := dec.Token(); err != nil { // closing brace
+		return nil, true, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, true, fmt.Errorf("unexpected data after the memo object")
+	}
+	return sections, true, nil
+}
+
+// Memo is a parsed and validated memo
+type Memo struct {
+	sections map[string]json.RawMessage
+}
+
+// Has returns true if the memo contains the key
+func (m Memo) Has(key string) bool {
+	_, ok := m.sections[key]
+	return ok
+}
+
+// Raw returns the raw JSON value of the section
+func (m Memo) Raw(key string) (json.RawMessage, bool) {
+	value, ok := m.sections[key]
+	return value, ok
+}
+
+// Section decodes the section into v. found is false if the memo doesn't contain the key.
+func (m Memo) Section(key string, v interface{}) (found bool, err error) {
+	value, ok := m.sections[key]
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(value, v)
+}
+
+// Without returns the memo without the given keys, as middlewares do when they consume their section before
+// passing the packet down the stack. An empty string is returned if no keys remain.
+func (m Memo) Without(keys ...string) (string, error) {
+	remaining := make(map[string]json.RawMessage, len(m.sections))
+	for key, value := range m.sections {
+		remaining[key] = value
+	}
+	for _, key := range keys {
+		delete(remaining, key)
+	}
+	if len(remaining) == 0 {
+		return "", nil
+	}
+
+	bz, err := json.Marshal(remaining)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
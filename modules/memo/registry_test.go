@@ -0,0 +1,133 @@
+package memo
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type forwardSection struct {
+	Receiver string `json:"receiver"`
+	Port     string `json:"port"`
+	Channel  string `json:"channel"`
+}
+
+func newTestRegistry(t *testing.T, config Config) *Registry {
+	t.Helper()
+	registry := NewRegistry(config)
+	if err := registry.Register("wasm", "ibchooks", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.Register("forward", "packetforward", func(section json.RawMessage) error {
+		var forward forwardSection
+		if err := json.Unmarshal(section, &forward); err != nil {
+			return err
+		}
+		if forward.Channel == "" {
+			return errors.New("channel is required")
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return registry
+}
+
+func TestRegister(t *testing.T) {
+	registry := newTestRegistry(t, Config{})
+	if err := registry.Register("wasm", "other", nil); err == nil {
+		t.Fatal("registering a key twice should fail")
+	}
+	if err := registry.Register("", "other", nil); err == nil {
+		t.Fatal("registering an empty key should fail")
+	}
+	if keys := registry.Keys(); !reflect.DeepEqual([]string{"forward", "wasm"}, keys) {
+		t.Fatalf("unexpected keys %v", keys)
+	}
+
+	owner, ok := registry.Owner("forward")
+	if !ok || owner != "packetforward" {
+		t.Fatalf("unexpected owner %q", owner)
+	}
+}
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config Config
+		memo   string
+		expErr bool
+	}{
+		{"empty memo", Config{}, "", false},
+		{"plain text memo", Config{RejectUnknownKeys: true}, "hello", false},
+		{"json array memo", Config{RejectUnknownKeys: true}, `["wasm"]`, false},
+		{"valid sections", Config{}, `{"wasm":{},"forward":{"channel":"channel-0"}}`, false},
+		{"invalid section", Config{}, `{"forward":{"port":"transfer"}}`, true},
+		{"unknown key allowed", Config{}, `{"other":1}`, false},
+		{"unknown key rejected", Config{RejectUnknownKeys: true}, `{"other":1}`, true},
+		{"duplicate key allowed", Config{}, `{"wasm":{},"wasm":{}}`, false},
+		{"duplicate key rejected", Config{RejectDuplicateKeys: true}, `{"wasm":{},"wasm":{}}`, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newTestRegistry(t, tc.config).Parse(tc.memo)
+			if tc.expErr != (err != nil) {
+				t.Fatalf("expected error: %t, got %v", tc.expErr, err)
+			}
+		})
+	}
+}
+
+func TestParseWithoutRegistry(t *testing.T) {
+	for _, notObject := range []string{"", "hello", `["wasm"]`, `{"wasm":`} {
+		parsed, err := Parse(notObject)
+		if err != nil || parsed.Has("wasm") {
+			t.Fatalf("%q should parse as an empty memo, got %v", notObject, err)
+		}
+	}
+
+	// no section is validated and the last duplicate wins, as with encoding/json
+	parsed, err := Parse(`{"forward":{"port":"transfer"},"other":1,"other":2}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var other int
+	if found, err := parsed.Section("other", &other); !found || err != nil || other != 2 {
+		t.Fatalf("unexpected other section %d: %v", other, err)
+	}
+}
+
+func TestMemoSections(t *testing.T) {
+	registry := newTestRegistry(t, Config{})
+	memo, err := registry.Parse(`{"wasm":{"contract":"addr"},"forward":{"receiver":"r","port":"transfer","channel":"channel-0"}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !memo.Has("wasm") || memo.Has("ibc_callback") {
+		t.Fatal("unexpected sections")
+	}
+
+	var forward forwardSection
+	found, err := memo.Section("forward", &forward)
+	if err != nil || !found {
+		t.Fatalf("forward section not found: %v", err)
+	}
+	if expected := (forwardSection{Receiver: "r", Port: "transfer", Channel: "channel-0"}); forward != expected {
+		t.Fatalf("unexpected forward section %+v", forward)
+	}
+
+	remaining, err := memo.Without("forward")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remaining != `{"wasm":{"contract":"addr"}}` {
+		t.Fatalf("unexpected remaining memo %s", remaining)
+	}
+
+	remaining, err = memo.Without("forward", "wasm")
+	if err != nil || remaining != "" {
+		t.Fatalf("unexpected remaining memo %q: %v", remaining, err)
+	}
+}